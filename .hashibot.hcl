@@ -231,6 +231,9 @@ behavior "regexp_issue_labeler_v2" "service_labels" {
     "service/dax" = [
       "aws_dax_",
     ],
+    "service/detective" = [
+      "aws_detective_",
+    ],
     "service/devicefarm" = [
       "aws_devicefarm_",
     ],
@@ -464,6 +467,9 @@ behavior "regexp_issue_labeler_v2" "service_labels" {
     "service/redshift" = [
       "aws_redshift_",
     ],
+    "service/rekognition" = [
+      "aws_rekognition_",
+    ],
     "service/resourcegroups" = [
       "aws_resourcegroups_",
     ],
@@ -815,6 +821,10 @@ behavior "pull_request_path_labeler" "service_labels" {
       "**/*_dax_*",
       "**/dax_*"
     ]
+    "service/detective" = [
+      "**/*_detective_*",
+      "**/detective_*"
+    ]
     "service/devicefarm" = [
       "**/*_devicefarm_*",
       "**/devicefarm_*"
@@ -1160,6 +1170,10 @@ behavior "pull_request_path_labeler" "service_labels" {
       "**/*_redshift_*",
       "**/redshift_*"
     ]
+    "service/rekognition" = [
+      "**/*_rekognition_*",
+      "**/rekognition_*"
+    ]
     "service/resourcegroups" = [
       "**/*_resourcegroups_*",
       "**/resourcegroups_*"
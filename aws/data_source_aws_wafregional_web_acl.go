@@ -2,7 +2,10 @@ package aws
 
 import (
 	"fmt"
+	"sort"
+
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -12,10 +15,76 @@ func dataSourceAwsWafRegionalWebAcl() *schema.Resource {
 		Read: dataSourceAwsWafRegionalWebAclRead,
 
 		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_action": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"metric_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			// Rules are exposed as a list ordered by priority, which is the
+			// order in which both WAF Classic and WAFv2 evaluate them.
+			"rule": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"override_action": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"rule_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -56,5 +125,47 @@ func dataSourceAwsWafRegionalWebAclRead(d *schema.ResourceData, meta interface{}
 
 	d.SetId(aws.StringValue(acl.WebACLId))
 
+	output, err := conn.GetWebACL(&waf.GetWebACLInput{
+		WebACLId: acl.WebACLId,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading web ACL (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.WebACL == nil {
+		return fmt.Errorf("error reading web ACL (%s): empty result", d.Id())
+	}
+
+	webACL := output.WebACL
+
+	// The WAF API currently omits this, but use it when it becomes available
+	webACLARN := aws.StringValue(webACL.WebACLArn)
+	if webACLARN == "" {
+		webACLARN = arn.ARN{
+			AccountID: meta.(*AWSClient).accountid,
+			Partition: meta.(*AWSClient).partition,
+			Region:    meta.(*AWSClient).region,
+			Resource:  fmt.Sprintf("webacl/%s", d.Id()),
+			Service:   "waf-regional",
+		}.String()
+	}
+	d.Set("arn", webACLARN)
+
+	if err := d.Set("default_action", flattenWafAction(webACL.DefaultAction)); err != nil {
+		return fmt.Errorf("error setting default_action: %s", err)
+	}
+
+	d.Set("metric_name", webACL.MetricName)
+
+	rules := flattenWafWebAclRules(webACL.Rules)
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i]["priority"].(int) < rules[j]["priority"].(int)
+	})
+
+	if err := d.Set("rule", rules); err != nil {
+		return fmt.Errorf("error setting rule: %s", err)
+	}
+
 	return nil
 }
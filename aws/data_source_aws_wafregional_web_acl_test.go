@@ -27,6 +27,17 @@ func TestAccDataSourceAwsWafRegionalWebAcl_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrPair(datasourceName, "id", resourceName, "id"),
 					resource.TestCheckResourceAttrPair(datasourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(datasourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(datasourceName, "metric_name", resourceName, "metric_name"),
+					resource.TestCheckResourceAttr(datasourceName, "default_action.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "default_action.0.type", "ALLOW"),
+					resource.TestCheckResourceAttr(datasourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(datasourceName, "rule.0.priority", "1"),
+					resource.TestCheckResourceAttrPair(datasourceName, "rule.0.rule_id", "aws_wafregional_rule.test2", "id"),
+					resource.TestCheckResourceAttr(datasourceName, "rule.0.action.0.type", "BLOCK"),
+					resource.TestCheckResourceAttr(datasourceName, "rule.1.priority", "2"),
+					resource.TestCheckResourceAttrPair(datasourceName, "rule.1.rule_id", "aws_wafregional_rule.test1", "id"),
+					resource.TestCheckResourceAttr(datasourceName, "rule.1.action.0.type", "COUNT"),
 				),
 			},
 		},
@@ -35,6 +46,16 @@ func TestAccDataSourceAwsWafRegionalWebAcl_basic(t *testing.T) {
 
 func testAccDataSourceAwsWafRegionalWebAclConfig_Name(name string) string {
 	return fmt.Sprintf(`
+resource "aws_wafregional_rule" "test1" {
+  name        = "%[1]s-1"
+  metric_name = "tfWebACLRule1"
+}
+
+resource "aws_wafregional_rule" "test2" {
+  name        = "%[1]s-2"
+  metric_name = "tfWebACLRule2"
+}
+
 resource "aws_wafregional_web_acl" "web_acl" {
   name        = %[1]q
   metric_name = "tfWebACL"
@@ -42,6 +63,24 @@ resource "aws_wafregional_web_acl" "web_acl" {
   default_action {
     type = "ALLOW"
   }
+
+  rule {
+    priority = 2
+    rule_id  = aws_wafregional_rule.test1.id
+
+    action {
+      type = "COUNT"
+    }
+  }
+
+  rule {
+    priority = 1
+    rule_id  = aws_wafregional_rule.test2.id
+
+    action {
+      type = "BLOCK"
+    }
+  }
 }
 
 data "aws_wafregional_web_acl" "web_acl" {
@@ -62,6 +62,7 @@ var serviceNames = []string{
 	"elb",
 	"elbv2",
 	"firehose",
+	"fms",
 	"fsx",
 	"gamelift",
 	"glacier",
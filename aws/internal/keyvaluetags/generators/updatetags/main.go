@@ -65,6 +65,7 @@ var serviceNames = []string{
 	"elbv2",
 	"emr",
 	"firehose",
+	"fms",
 	"fsx",
 	"gamelift",
 	"glacier",
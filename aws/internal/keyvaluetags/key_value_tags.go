@@ -21,6 +21,22 @@ const (
 	RdsTagKeyPrefix              = `rds:`
 )
 
+// DefaultConfig contains tags to default across all resources.
+type DefaultConfig struct {
+	Tags KeyValueTags
+}
+
+// MergeTags returns the result of keyvaluetags.Merge() on the given
+// DefaultConfig.Tags with KeyValueTags provided as an argument,
+// overriding the value of any tag with a matching key.
+func (dc *DefaultConfig) MergeTags(tags KeyValueTags) KeyValueTags {
+	if dc == nil || dc.Tags == nil {
+		return tags
+	}
+
+	return dc.Tags.Merge(tags)
+}
+
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
 	Keys        KeyValueTags
@@ -58,6 +74,38 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 	return result
 }
 
+// RemoveDefaultConfig returns tags not present in a DefaultConfig, as well as
+// tags whose values override those in the DefaultConfig.
+func (tags KeyValueTags) RemoveDefaultConfig(dc *DefaultConfig) KeyValueTags {
+	if dc == nil || dc.Tags == nil {
+		return tags
+	}
+
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		defaultValue, ok := dc.Tags[k]
+
+		if !ok {
+			result[k] = v
+			continue
+		}
+
+		if v == nil || defaultValue == nil {
+			if v != defaultValue {
+				result[k] = v
+			}
+			continue
+		}
+
+		if *v != *defaultValue {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
 // IgnoreElasticbeanstalk returns non-AWS and non-Elasticbeanstalk tag keys.
 func (tags KeyValueTags) IgnoreElasticbeanstalk() KeyValueTags {
 	result := make(KeyValueTags)
@@ -54,6 +54,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/emr"
 	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/fms"
 	"github.com/aws/aws-sdk-go/service/fsx"
 	"github.com/aws/aws-sdk-go/service/gamelift"
 	"github.com/aws/aws-sdk-go/service/glacier"
@@ -218,6 +219,8 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(emr.New)
 	case "firehose":
 		funcType = reflect.TypeOf(firehose.New)
+	case "fms":
+		funcType = reflect.TypeOf(fms.New)
 	case "fsx":
 		funcType = reflect.TypeOf(fsx.New)
 	case "gamelift":
@@ -489,6 +492,8 @@ func ServiceListTagsOutputTagsField(serviceName string) string {
 		return "TagDescriptions[0].Tags"
 	case "elbv2":
 		return "TagDescriptions[0].Tags"
+	case "fms":
+		return "TagList"
 	case "mediaconvert":
 		return "ResourceTags.Tags"
 	case "neptune":
@@ -767,6 +772,8 @@ func ServiceTagInputTagsField(serviceName string) string {
 		return "TagsToAdd"
 	case "elasticsearchservice":
 		return "TagList"
+	case "fms":
+		return "TagList"
 	case "glue":
 		return "TagsToAdd"
 	case "pinpoint":
@@ -830,8 +837,6 @@ func ServiceTagType(serviceName string) string {
 		return "TagRef"
 	case "datasync":
 		return "TagListEntry"
-	case "fms":
-		return "ResourceTag"
 	case "swf":
 		return "ResourceTag"
 	default:
@@ -0,0 +1,63 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+)
+
+// GraphByARN returns the behavior graph corresponding to the specified ARN.
+// Returns nil if no graph is found.
+func GraphByARN(conn *detective.Detective, arn string) (*detective.Graph, error) {
+	var graph *detective.Graph
+
+	err := conn.ListGraphsPages(&detective.ListGraphsInput{}, func(page *detective.ListGraphsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, g := range page.GraphList {
+			if g != nil && aws.StringValue(g.Arn) == arn {
+				graph = g
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return graph, nil
+}
+
+// MemberByGraphARNAndAccountID returns the member account of the specified behavior graph.
+// Returns nil if no member is found.
+func MemberByGraphARNAndAccountID(conn *detective.Detective, graphARN, accountID string) (*detective.MemberDetail, error) {
+	input := &detective.ListMembersInput{
+		GraphArn: aws.String(graphARN),
+	}
+	var member *detective.MemberDetail
+
+	err := conn.ListMembersPages(input, func(page *detective.ListMembersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, m := range page.MemberDetails {
+			if m != nil && aws.StringValue(m.AccountId) == accountID {
+				member = m
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
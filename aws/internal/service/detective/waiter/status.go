@@ -0,0 +1,30 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/finder"
+)
+
+const (
+	MemberStatusNotFound = "NotFound"
+	MemberStatusUnknown  = "Unknown"
+)
+
+// MemberStatus fetches the Member and its Status
+func MemberStatus(conn *detective.Detective, graphARN, accountID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		member, err := finder.MemberByGraphARNAndAccountID(conn, graphARN, accountID)
+
+		if err != nil {
+			return nil, MemberStatusUnknown, err
+		}
+
+		if member == nil {
+			return nil, MemberStatusNotFound, nil
+		}
+
+		return member, aws.StringValue(member.Status), nil
+	}
+}
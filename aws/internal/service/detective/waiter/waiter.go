@@ -0,0 +1,43 @@
+package waiter
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a Member to be verified
+	MemberVerifiedTimeout = 5 * time.Minute
+)
+
+// MemberVerified waits for Detective to finish verifying a Member's email address.
+// An error is returned if verification fails.
+func MemberVerified(conn *detective.Detective, graphARN, accountID string) (*detective.MemberDetail, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{detective.MemberStatusVerificationInProgress},
+		Target: []string{
+			detective.MemberStatusVerificationFailed,
+			detective.MemberStatusInvited,
+			detective.MemberStatusEnabled,
+			detective.MemberStatusAcceptedButDisabled,
+		},
+		Refresh: MemberStatus(conn, graphARN, accountID),
+		Timeout: MemberVerifiedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*detective.MemberDetail); ok {
+		if err == nil && aws.StringValue(v.Status) == detective.MemberStatusVerificationFailed {
+			err = errors.New("email address verification failed; the email address must match the root user email address of the member account")
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
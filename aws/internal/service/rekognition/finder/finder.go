@@ -0,0 +1,33 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rekognition"
+)
+
+// ProjectByARN returns the Custom Labels project corresponding to the specified ARN.
+// Returns nil if no project is found.
+func ProjectByARN(conn *rekognition.Rekognition, arn string) (*rekognition.ProjectDescription, error) {
+	var project *rekognition.ProjectDescription
+
+	err := conn.DescribeProjectsPages(&rekognition.DescribeProjectsInput{}, func(page *rekognition.DescribeProjectsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, p := range page.ProjectDescriptions {
+			if p != nil && aws.StringValue(p.ProjectArn) == arn {
+				project = p
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
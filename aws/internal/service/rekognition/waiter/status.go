@@ -0,0 +1,30 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rekognition/finder"
+)
+
+const (
+	ProjectStatusDeleted = "DELETED"
+	ProjectStatusUnknown = "UNKNOWN"
+)
+
+// ProjectStatus fetches the Project and its Status
+func ProjectStatus(conn *rekognition.Rekognition, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		project, err := finder.ProjectByARN(conn, arn)
+
+		if err != nil {
+			return nil, ProjectStatusUnknown, err
+		}
+
+		if project == nil {
+			return &rekognition.ProjectDescription{}, ProjectStatusDeleted, nil
+		}
+
+		return project, aws.StringValue(project.Status), nil
+	}
+}
@@ -0,0 +1,52 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a Project to return Created
+	ProjectCreatedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a Project to return Deleted
+	ProjectDeletedTimeout = 5 * time.Minute
+)
+
+// ProjectCreated waits for a Project to return Created
+func ProjectCreated(conn *rekognition.Rekognition, arn string) (*rekognition.ProjectDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{rekognition.ProjectStatusCreating},
+		Target:  []string{rekognition.ProjectStatusCreated},
+		Refresh: ProjectStatus(conn, arn),
+		Timeout: ProjectCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*rekognition.ProjectDescription); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// ProjectDeleted waits for a Project to return Deleted
+func ProjectDeleted(conn *rekognition.Rekognition, arn string) (*rekognition.ProjectDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{rekognition.ProjectStatusCreated, rekognition.ProjectStatusDeleting},
+		Target:  []string{ProjectStatusDeleted},
+		Refresh: ProjectStatus(conn, arn),
+		Timeout: ProjectDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*rekognition.ProjectDescription); ok {
+		return v, err
+	}
+
+	return nil, err
+}
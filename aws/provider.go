@@ -88,6 +88,23 @@ func Provider() *schema.Provider {
 				Set:           schema.HashString,
 			},
 
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to default resource tags on supporting resources (currently aws_ami, aws_ami_copy and aws_ami_from_instance).",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tags to default on supporting resources.",
+						},
+					},
+				},
+			},
+
 			"endpoints": endpointsSchema(),
 
 			"ignore_tags": {
@@ -498,6 +515,8 @@ func Provider() *schema.Provider {
 			"aws_dax_cluster":                                         resourceAwsDaxCluster(),
 			"aws_dax_parameter_group":                                 resourceAwsDaxParameterGroup(),
 			"aws_dax_subnet_group":                                    resourceAwsDaxSubnetGroup(),
+			"aws_detective_graph":                                     resourceAwsDetectiveGraph(),
+			"aws_detective_member":                                    resourceAwsDetectiveMember(),
 			"aws_db_cluster_snapshot":                                 resourceAwsDbClusterSnapshot(),
 			"aws_db_event_subscription":                               resourceAwsDbEventSubscription(),
 			"aws_db_instance":                                         resourceAwsDbInstance(),
@@ -610,6 +629,7 @@ func Provider() *schema.Provider {
 			"aws_fsx_lustre_file_system":                              resourceAwsFsxLustreFileSystem(),
 			"aws_fsx_windows_file_system":                             resourceAwsFsxWindowsFileSystem(),
 			"aws_fms_admin_account":                                   resourceAwsFmsAdminAccount(),
+			"aws_fms_policy":                                          resourceAwsFmsPolicy(),
 			"aws_gamelift_alias":                                      resourceAwsGameliftAlias(),
 			"aws_gamelift_build":                                      resourceAwsGameliftBuild(),
 			"aws_gamelift_fleet":                                      resourceAwsGameliftFleet(),
@@ -771,6 +791,8 @@ func Provider() *schema.Provider {
 			"aws_redshift_snapshot_schedule":                          resourceAwsRedshiftSnapshotSchedule(),
 			"aws_redshift_snapshot_schedule_association":              resourceAwsRedshiftSnapshotScheduleAssociation(),
 			"aws_redshift_event_subscription":                         resourceAwsRedshiftEventSubscription(),
+			"aws_rekognition_project":                                 resourceAwsRekognitionProject(),
+			"aws_rekognition_stream_processor":                        resourceAwsRekognitionStreamProcessor(),
 			"aws_resourcegroups_group":                                resourceAwsResourceGroupsGroup(),
 			"aws_route53_delegation_set":                              resourceAwsRoute53DelegationSet(),
 			"aws_route53_query_log":                                   resourceAwsRoute53QueryLog(),
@@ -833,6 +855,8 @@ func Provider() *schema.Provider {
 			"aws_service_discovery_public_dns_namespace":              resourceAwsServiceDiscoveryPublicDnsNamespace(),
 			"aws_service_discovery_service":                           resourceAwsServiceDiscoveryService(),
 			"aws_servicequotas_service_quota":                         resourceAwsServiceQuotasServiceQuota(),
+			"aws_servicequotas_template":                              resourceAwsServiceQuotasTemplate(),
+			"aws_servicequotas_template_association":                  resourceAwsServiceQuotasTemplateAssociation(),
 			"aws_shield_protection":                                   resourceAwsShieldProtection(),
 			"aws_simpledb_domain":                                     resourceAwsSimpleDBDomain(),
 			"aws_ssm_activation":                                      resourceAwsSsmActivation(),
@@ -941,6 +965,7 @@ func Provider() *schema.Provider {
 			"aws_pinpoint_event_stream":                               resourceAwsPinpointEventStream(),
 			"aws_pinpoint_gcm_channel":                                resourceAwsPinpointGCMChannel(),
 			"aws_pinpoint_sms_channel":                                resourceAwsPinpointSMSChannel(),
+			"aws_polly_lexicon":                                       resourceAwsPollyLexicon(),
 			"aws_xray_encryption_config":                              resourceAwsXrayEncryptionConfig(),
 			"aws_xray_group":                                          resourceAwsXrayGroup(),
 			"aws_xray_sampling_rule":                                  resourceAwsXraySamplingRule(),
@@ -1070,6 +1095,7 @@ func init() {
 		"datapipeline",
 		"datasync",
 		"dax",
+		"detective",
 		"devicefarm",
 		"directconnect",
 		"dlm",
@@ -1132,12 +1158,14 @@ func init() {
 		"outposts",
 		"personalize",
 		"pinpoint",
+		"polly",
 		"pricing",
 		"qldb",
 		"quicksight",
 		"ram",
 		"rds",
 		"redshift",
+		"rekognition",
 		"resourcegroups",
 		"resourcegroupstaggingapi",
 		"route53",
@@ -1182,6 +1210,7 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		Token:                   d.Get("token").(string),
 		Region:                  d.Get("region").(string),
 		CredsFilename:           d.Get("shared_credentials_file").(string),
+		DefaultTagsConfig:       expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
 		Endpoints:               make(map[string]string),
 		MaxRetries:              d.Get("max_retries").(int),
 		IgnoreTagsConfig:        expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
@@ -1362,6 +1391,21 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func expandProviderDefaultTags(l []interface{}) *keyvaluetags.DefaultConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	defaultConfig := &keyvaluetags.DefaultConfig{}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["tags"].(map[string]interface{}); ok {
+		defaultConfig.Tags = keyvaluetags.New(v)
+	}
+
+	return defaultConfig
+}
+
 func expandProviderIgnoreTags(l []interface{}) *keyvaluetags.IgnoreConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -2,16 +2,21 @@ package aws
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"log"
+	"math/rand"
+	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -21,9 +26,32 @@ import (
 
 const (
 	AWSAMIRetryTimeout       = 40 * time.Minute
+	AWSAMIReadRetryTimeout   = 1 * time.Minute
 	AWSAMIDeleteRetryTimeout = 90 * time.Minute
 	AWSAMIRetryDelay         = 5 * time.Second
 	AWSAMIRetryMinTimeout    = 3 * time.Second
+
+	// Upper bound on the minimum interval between polls derived from the
+	// operation timeout by resourceAwsAmiWaitMinTimeout.
+	AWSAMIRetryMaxMinTimeout = 30 * time.Second
+
+	// Number of times a throttled DescribeImages call is retried within a
+	// single state refresh, and the base pause before the first retry. The
+	// pause doubles, with jitter, on each retry; the total stays well below
+	// AWSAMIRetryMinTimeout, the shortest interval between polls.
+	AWSAMIRefreshThrottleRetries = 3
+	AWSAMIRefreshThrottleDelay   = 250 * time.Millisecond
+
+	// Maximum number of managed EBS snapshots deleted concurrently.
+	AWSAMISnapshotDeleteConcurrency = 4
+
+	// Maximum amount of time to retry deleting each snapshot of the
+	// intermediate image aws_ami_from_instance creates for an encrypted copy.
+	AWSAMIIntermediateSnapshotDeleteTimeout = 5 * time.Minute
+
+	// Period after an AMI's creation date during which InvalidAMIID.NotFound
+	// is treated as eventual consistency rather than deletion.
+	AWSAMIRecentlyCreatedGracePeriod = 10 * time.Minute
 )
 
 func resourceAwsAmi() *schema.Resource {
@@ -36,23 +64,34 @@ func resourceAwsAmi() *schema.Resource {
 		Update: resourceAwsAmiUpdate,
 		Delete: resourceAwsAmiDelete,
 
+		CustomizeDiff: customdiff.Sequence(
+			resourceAwsAmiCustomizeDiffBlockDeviceNames,
+			resourceAwsAmiCustomizeDiffBlockDeviceEncryption,
+			resourceAwsAmiCustomizeDiffEbsVolumeSize,
+			setTagsDiff,
+		),
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(AWSAMIRetryTimeout),
+			Read:   schema.DefaultTimeout(AWSAMIReadRetryTimeout),
 			Update: schema.DefaultTimeout(AWSAMIRetryTimeout),
 			Delete: schema.DefaultTimeout(AWSAMIDeleteRetryTimeout),
 		},
 
 		Schema: map[string]*schema.Schema{
 			"image_location": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAmiImageLocation,
 			},
+			// The architecture of a registered image cannot be modified, so
+			// changing it deregisters the AMI and registers a new one.
 			"architecture": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -64,6 +103,18 @@ func resourceAwsAmi() *schema.Resource {
 					ec2.ArchitectureValuesArm64,
 				}, false),
 			},
+			// Billing product codes are only accepted by RegisterImage for
+			// accounts authorized by AWS, and are not returned by DescribeImages.
+			"billing_products": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -80,6 +131,8 @@ func resourceAwsAmi() *schema.Resource {
 				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						// Part of the registered block device mapping, which
+						// cannot be modified, so changing it registers a new AMI.
 						"delete_on_termination": {
 							Type:     schema.TypeBool,
 							Optional: true,
@@ -103,6 +156,13 @@ func resourceAwsAmi() *schema.Resource {
 							Type:     schema.TypeInt,
 							Optional: true,
 							ForceNew: true,
+							// io2 Block Express volumes support up to 256,000 IOPS.
+							ValidateFunc: validation.IntBetween(0, 256000),
+						},
+
+						"kms_key_id": {
+							Type:     schema.TypeString,
+							Computed: true,
 						},
 
 						"snapshot_id": {
@@ -126,6 +186,7 @@ func resourceAwsAmi() *schema.Resource {
 							ValidateFunc: validation.StringInSlice([]string{
 								ec2.VolumeTypeStandard,
 								ec2.VolumeTypeIo1,
+								ec2.VolumeTypeIo2,
 								ec2.VolumeTypeGp2,
 								ec2.VolumeTypeSc1,
 								ec2.VolumeTypeSt1,
@@ -141,11 +202,17 @@ func resourceAwsAmi() *schema.Resource {
 					return hashcode.String(buf.String())
 				},
 			},
+			// ModifyImageAttribute cannot change ENA support on a registered
+			// image, so the only way to toggle it is to register a new one.
 			"ena_support": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				ForceNew: true,
 			},
+			"encrypted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"ephemeral_block_device": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -159,8 +226,9 @@ func resourceAwsAmi() *schema.Resource {
 						},
 
 						"virtual_name": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^ephemeral\d+$`), "must be in the format ephemeralN"),
 						},
 					},
 				},
@@ -173,9 +241,17 @@ func resourceAwsAmi() *schema.Resource {
 				},
 			},
 			"kernel_id": {
-				Type:     schema.TypeString,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: resourceAwsAmiDiffSuppressEmptyId,
+			},
+			// Not an attribute of the image; controls whether an image that fails to
+			// become available after registration is deregistered during create.
+			"keep_on_failure": {
+				Type:     schema.TypeBool,
 				Optional: true,
-				ForceNew: true,
+				Default:  false,
 			},
 			// Not a public attribute; used to let the aws_ami_copy and aws_ami_from_instance
 			// resources record that they implicitly created new EBS snapshots that we should
@@ -186,31 +262,84 @@ func resourceAwsAmi() *schema.Resource {
 				Computed: true,
 			},
 			"name": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"name", "name_prefix"},
 			},
-			"ramdisk_id": {
-				Type:     schema.TypeString,
+			"name_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"name", "name_prefix"},
+				// AMI names are at most 128 characters and
+				// resource.PrefixedUniqueId appends 26 characters.
+				ValidateFunc: validation.StringLenBetween(1, 102),
+			},
+			"public": {
+				Type:     schema.TypeBool,
 				Optional: true,
-				ForceNew: true,
+				Computed: true,
+			},
+			"product_codes": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Set:      amiProductCodesHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"product_code_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"product_code_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ramdisk_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: resourceAwsAmiDiffSuppressEmptyId,
 			},
 			"root_device_name": {
 				Type:     schema.TypeString,
 				Optional: true,
 				ForceNew: true,
 			},
+			"root_device_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"root_snapshot_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			// Not populated for aws_ami; present so that the lineage attributes
+			// of aws_ami_copy and aws_ami_from_instance exist on all AMI resources.
+			"source_ami_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_ami_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"sriov_net_support": {
 				Type:     schema.TypeString,
 				Optional: true,
 				ForceNew: true,
 				Default:  "simple",
 			},
-			"tags": tagsSchema(),
+			"state_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 			"virtualization_type": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -232,8 +361,15 @@ func resourceAwsAmi() *schema.Resource {
 func resourceAwsAmiCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AWSClient).ec2conn
 
+	var name string
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	} else {
+		name = resource.PrefixedUniqueId(d.Get("name_prefix").(string))
+	}
+
 	req := &ec2.RegisterImageInput{
-		Name:               aws.String(d.Get("name").(string)),
+		Name:               aws.String(name),
 		Description:        aws.String(d.Get("description").(string)),
 		Architecture:       aws.String(d.Get("architecture").(string)),
 		ImageLocation:      aws.String(d.Get("image_location").(string)),
@@ -243,6 +379,10 @@ func resourceAwsAmiCreate(d *schema.ResourceData, meta interface{}) error {
 		EnaSupport:         aws.Bool(d.Get("ena_support").(bool)),
 	}
 
+	if v, ok := d.GetOk("billing_products"); ok && len(v.([]interface{})) > 0 {
+		req.BillingProducts = expandStringList(v.([]interface{}))
+	}
+
 	if kernelId := d.Get("kernel_id").(string); kernelId != "" {
 		req.KernelId = aws.String(kernelId)
 	}
@@ -271,13 +411,14 @@ func resourceAwsAmiCreate(d *schema.ResourceData, meta interface{}) error {
 				blockDev.Ebs.VolumeSize = aws.Int64(int64(s))
 			}
 		}
-		encrypted := ebsBlockDev["encrypted"].(bool)
+		// Normally caught at plan time, but the snapshot ID may not have been
+		// known then.
+		if err := resourceAwsAmiValidateEbsBlockDeviceEncryption(ebsBlockDev); err != nil {
+			return err
+		}
 		if snapshotId := ebsBlockDev["snapshot_id"].(string); snapshotId != "" {
 			blockDev.Ebs.SnapshotId = aws.String(snapshotId)
-			if encrypted {
-				return errors.New("can't set both 'snapshot_id' and 'encrypted'")
-			}
-		} else if encrypted {
+		} else if ebsBlockDev["encrypted"].(bool) {
 			blockDev.Ebs.Encrypted = aws.Bool(true)
 		}
 		req.BlockDeviceMappings = append(req.BlockDeviceMappings, blockDev)
@@ -292,24 +433,53 @@ func resourceAwsAmiCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	res, err := client.RegisterImage(req)
+	if isAWSErr(err, "InvalidAMIName.Duplicate", "") {
+		return fmt.Errorf("error registering AMI: an AMI named %q already exists in this region and account; "+
+			"deregister it, choose another name, or use name_prefix to generate a unique name: %w", name, err)
+	}
 	if err != nil {
-		return err
+		return fmt.Errorf("error registering AMI (%s): %w", name, err)
 	}
 
 	id := *res.ImageId
 	d.SetId(id)
 
-	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
-		if err := keyvaluetags.Ec2CreateTags(client, id, v); err != nil {
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		if err := keyvaluetags.Ec2CreateTags(client, id, tags.IgnoreAws().Map()); err != nil {
 			return fmt.Errorf("error adding tags: %s", err)
 		}
 	}
 
 	_, err = resourceAwsAmiWaitForAvailable(d.Timeout(schema.TimeoutCreate), id, client)
 	if err != nil {
+		if d.Get("keep_on_failure").(bool) {
+			return err
+		}
+
+		// Don't leave behind a registered image that never became available.
+		log.Printf("[WARN] AMI (%s) did not become available, deregistering it", id)
+		_, deregisterErr := client.DeregisterImage(&ec2.DeregisterImageInput{
+			ImageId: aws.String(id),
+		})
+
+		if deregisterErr != nil && !isAWSErr(deregisterErr, "InvalidAMIID.NotFound", "") && !isAWSErr(deregisterErr, "InvalidAMIID.Unavailable", "") {
+			// Keep the ID so the image is recorded as tainted and replaced later.
+			return fmt.Errorf("%w; additionally, error deregistering AMI (%s): %s", err, id, deregisterErr)
+		}
+
+		d.SetId("")
 		return err
 	}
 
+	if v, ok := d.GetOk("public"); ok && v.(bool) {
+		if err := resourceAwsAmiSetPublic(client, id, true); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsAmiRead(d, meta)
 }
 
@@ -319,17 +489,15 @@ func resourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
 
 	id := d.Id()
 
-	req := &ec2.DescribeImagesInput{
-		ImageIds: []*string{aws.String(id)},
-	}
-
-	var res *ec2.DescribeImagesOutput
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+	var images []*ec2.Image
+	err := resource.Retry(d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
 		var err error
-		res, err = client.DescribeImages(req)
+		images, err = resourceAwsAmiDescribeImages(client, id)
 		if err != nil {
 			if isAWSErr(err, "InvalidAMIID.NotFound", "") {
-				if d.IsNewResource() {
+				// Images, e.g. those copied across regions, can take a while
+				// to become visible after creation.
+				if d.IsNewResource() || resourceAwsAmiRecentlyCreated(d) {
 					return resource.RetryableError(err)
 				}
 				log.Printf("[WARN] AMI (%s) not found, removing from state", d.Id())
@@ -342,19 +510,32 @@ func resourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	})
 	if isResourceTimeoutError(err) {
-		res, err = client.DescribeImages(req)
+		images, err = resourceAwsAmiDescribeImages(client, id)
+	}
+	// A recently created AMI that is still not found once the grace period
+	// retries are exhausted was most likely deregistered outside of Terraform.
+	if isAWSErr(err, "InvalidAMIID.NotFound", "") && !d.IsNewResource() {
+		log.Printf("[WARN] AMI (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
 	}
 	if err != nil {
 		return fmt.Errorf("Unable to find AMI after retries: %s", err)
 	}
 
-	if len(res.Images) != 1 {
+	if len(images) == 0 {
 		log.Printf("[WARN] AMI (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
-	image := res.Images[0]
+	// A lookup by ID should never match more than one image; don't drop the
+	// resource from state on such an anomaly.
+	if len(images) > 1 {
+		return fmt.Errorf("error reading AMI (%s): expected 1 image, found %d", d.Id(), len(images))
+	}
+
+	image := images[0]
 	state := *image.State
 
 	if state == ec2.ImageStatePending {
@@ -363,7 +544,7 @@ func resourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
 		// before we continue. We should never take this branch in normal
 		// circumstances since we would've waited for availability during
 		// the "Create" step.
-		image, err = resourceAwsAmiWaitForAvailable(d.Timeout(schema.TimeoutCreate), id, client)
+		image, err = resourceAwsAmiWaitForAvailable(d.Timeout(schema.TimeoutRead), id, client)
 		if err != nil {
 			return err
 		}
@@ -376,7 +557,16 @@ func resourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
+	var stateReason string
+	if image.StateReason != nil {
+		stateReason = aws.StringValue(image.StateReason.Message)
+	}
+	d.Set("state_reason", stateReason)
+
 	if state != ec2.ImageStateAvailable {
+		if stateReason != "" {
+			return fmt.Errorf("AMI has become %s: %s", state, stateReason)
+		}
 		return fmt.Errorf("AMI has become %s", state)
 	}
 
@@ -384,14 +574,20 @@ func resourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("description", image.Description)
 	d.Set("image_location", image.ImageLocation)
 	d.Set("architecture", image.Architecture)
+	d.Set("creation_date", image.CreationDate)
 	d.Set("kernel_id", image.KernelId)
 	d.Set("ramdisk_id", image.RamdiskId)
 	d.Set("root_device_name", image.RootDeviceName)
+	d.Set("root_device_type", image.RootDeviceType)
 	d.Set("root_snapshot_id", amiRootSnapshotId(image))
 	d.Set("sriov_net_support", image.SriovNetSupport)
 	d.Set("virtualization_type", image.VirtualizationType)
 	d.Set("ena_support", image.EnaSupport)
 
+	if err := d.Set("product_codes", amiProductCodes(image.ProductCodes)); err != nil {
+		return fmt.Errorf("error setting product_codes: %s", err)
+	}
+
 	imageArn := arn.ARN{
 		Partition: meta.(*AWSClient).partition,
 		Region:    meta.(*AWSClient).region,
@@ -403,9 +599,12 @@ func resourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
 
 	var ebsBlockDevs []map[string]interface{}
 	var ephemeralBlockDevs []map[string]interface{}
+	encrypted := false
 
 	for _, blockDev := range image.BlockDeviceMappings {
 		if blockDev.Ebs != nil {
+			encrypted = encrypted || aws.BoolValue(blockDev.Ebs.Encrypted)
+
 			ebsBlockDev := map[string]interface{}{
 				"device_name":           *blockDev.DeviceName,
 				"delete_on_termination": *blockDev.Ebs.DeleteOnTermination,
@@ -417,6 +616,9 @@ func resourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
 			if blockDev.Ebs.Iops != nil {
 				ebsBlockDev["iops"] = int(*blockDev.Ebs.Iops)
 			}
+			if blockDev.Ebs.KmsKeyId != nil {
+				ebsBlockDev["kms_key_id"] = *blockDev.Ebs.KmsKeyId
+			}
 			// The snapshot ID might not be set.
 			if blockDev.Ebs.SnapshotId != nil {
 				ebsBlockDev["snapshot_id"] = *blockDev.Ebs.SnapshotId
@@ -433,25 +635,115 @@ func resourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("ebs_block_device", ebsBlockDevs)
 	d.Set("ephemeral_block_device", ephemeralBlockDevs)
 
-	if err := d.Set("tags", keyvaluetags.Ec2KeyValueTags(image.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+	// aws_ami_copy and aws_ami_from_instance take encrypted as an argument
+	// requesting encryption, which must not be overwritten here.
+	if !d.Get("manage_ebs_snapshots").(bool) {
+		d.Set("encrypted", encrypted)
+	}
+
+	public, err := resourceAwsAmiIsPublic(client, d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("public", public)
+
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := keyvaluetags.Ec2KeyValueTags(image.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	// Tags added during creation may not be visible immediately.
+	if d.IsNewResource() {
+		expectedTags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{}))).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+		if !tags.ContainsAll(expectedTags) {
+			errTagsNotYetAvailable := fmt.Errorf("AMI (%s) tags not yet available", d.Id())
+
+			err := resource.Retry(d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
+				images, err := resourceAwsAmiDescribeImages(client, id)
+				if err != nil {
+					return resource.NonRetryableError(err)
+				}
+
+				if len(images) == 1 {
+					tags = keyvaluetags.Ec2KeyValueTags(images[0].Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+				}
+
+				if !tags.ContainsAll(expectedTags) {
+					return resource.RetryableError(errTagsNotYetAvailable)
+				}
+
+				return nil
+			})
+
+			// resource.Retry returns the last retryable error once it times
+			// out. Fall back to the tags visible on the last attempt; any
+			// remaining difference will show up in the next plan.
+			if err == errTagsNotYetAvailable || isResourceTimeoutError(err) {
+				log.Printf("[WARN] AMI (%s) tags not all visible after waiting, using visible tags", d.Id())
+				err = nil
+			}
+
+			if err != nil {
+				return fmt.Errorf("error reading AMI (%s) tags: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %s", err)
+	}
+
 	return nil
 }
 
+// resourceAwsAmiRecentlyCreated returns whether the creation date recorded in
+// state lies within AWSAMIRecentlyCreatedGracePeriod.
+func resourceAwsAmiRecentlyCreated(d *schema.ResourceData) bool {
+	creationDate, err := time.Parse(time.RFC3339, d.Get("creation_date").(string))
+
+	if err != nil {
+		return false
+	}
+
+	return time.Since(creationDate) < AWSAMIRecentlyCreatedGracePeriod
+}
+
 func resourceAwsAmiUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AWSClient).ec2conn
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.Ec2UpdateTags(client, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating AMI (%s) tags: %s", d.Id(), err)
 		}
+
+		// Keep the tags on any snapshots we manage in step with the image.
+		if d.Get("manage_ebs_snapshots").(bool) {
+			for _, ebsBlockDevI := range d.Get("ebs_block_device").(*schema.Set).List() {
+				snapshotId := ebsBlockDevI.(map[string]interface{})["snapshot_id"].(string)
+				if snapshotId == "" {
+					continue
+				}
+
+				if err := keyvaluetags.Ec2UpdateTags(client, snapshotId, o, n); err != nil {
+					return fmt.Errorf("error updating AMI (%s) EBS snapshot (%s) tags: %s", d.Id(), snapshotId, err)
+				}
+			}
+		}
 	}
 
-	if d.Get("description").(string) != "" {
+	if d.HasChange("public") {
+		if err := resourceAwsAmiSetPublic(client, d.Id(), d.Get("public").(bool)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("description") && d.Get("description").(string) != "" {
 		_, err := client.ModifyImageAttribute(&ec2.ModifyImageAttributeInput{
 			ImageId: aws.String(d.Id()),
 			Description: &ec2.AttributeValue{
@@ -473,28 +765,68 @@ func resourceAwsAmiDelete(d *schema.ResourceData, meta interface{}) error {
 		ImageId: aws.String(d.Id()),
 	}
 
+	// An image that has already been deregistered, e.g. outside of Terraform,
+	// is reported as unavailable or not found; carry on with snapshot cleanup.
 	_, err := client.DeregisterImage(req)
-	if err != nil {
-		return err
+	if isAWSErr(err, "InvalidAMIID.NotFound", "") || isAWSErr(err, "InvalidAMIID.Unavailable", "") {
+		log.Printf("[WARN] AMI (%s) already deregistered", d.Id())
+	} else if err != nil {
+		return fmt.Errorf("error deregistering AMI (%s): %s", d.Id(), err)
 	}
 
 	// If we're managing the EBS snapshots then we need to delete those too.
 	if d.Get("manage_ebs_snapshots").(bool) {
 		errs := map[string]error{}
+		var errsMutex sync.Mutex
+		var wg sync.WaitGroup
+		sem := make(chan struct{}, AWSAMISnapshotDeleteConcurrency)
+
 		ebsBlockDevsSet := d.Get("ebs_block_device").(*schema.Set)
-		req := &ec2.DeleteSnapshotInput{}
 		for _, ebsBlockDevI := range ebsBlockDevsSet.List() {
 			ebsBlockDev := ebsBlockDevI.(map[string]interface{})
 			snapshotId := ebsBlockDev["snapshot_id"].(string)
-			if snapshotId != "" {
-				req.SnapshotId = aws.String(snapshotId)
-				_, err := client.DeleteSnapshot(req)
+			if snapshotId == "" {
+				continue
+			}
+
+			wg.Add(1)
+			sem <- struct{}{}
+
+			go func(snapshotId string) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+
+				input := &ec2.DeleteSnapshotInput{
+					SnapshotId: aws.String(snapshotId),
+				}
+
+				// The snapshot can remain associated with the image for a
+				// short while after it has been deregistered.
+				err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+					_, err := client.DeleteSnapshot(input)
+					if isAWSErr(err, "InvalidSnapshot.InUse", "") {
+						return resource.RetryableError(err)
+					}
+					if err != nil {
+						return resource.NonRetryableError(err)
+					}
+					return nil
+				})
+				if isResourceTimeoutError(err) {
+					_, err = client.DeleteSnapshot(input)
+				}
 				if err != nil {
+					errsMutex.Lock()
 					errs[snapshotId] = err
+					errsMutex.Unlock()
 				}
-			}
+			}(snapshotId)
 		}
 
+		wg.Wait()
+
 		if len(errs) > 0 {
 			errParts := []string{"Errors while deleting associated EBS snapshots:"}
 			for snapshotId, err := range errs {
@@ -513,40 +845,266 @@ func resourceAwsAmiDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceAwsAmiCustomizeDiffBlockDeviceNames(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("ebs_block_device") && !diff.HasChange("ephemeral_block_device") {
+		return nil
+	}
+
+	deviceNames := map[string]bool{}
+
+	for _, k := range []string{"ebs_block_device", "ephemeral_block_device"} {
+		for _, v := range diff.Get(k).(*schema.Set).List() {
+			deviceName := v.(map[string]interface{})["device_name"].(string)
+
+			if deviceName == "" {
+				continue
+			}
+
+			if deviceNames[deviceName] {
+				return fmt.Errorf("device_name %q is used by more than one block device", deviceName)
+			}
+
+			deviceNames[deviceName] = true
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsAmiCustomizeDiffBlockDeviceEncryption(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("ebs_block_device") {
+		return nil
+	}
+
+	for _, ebsBlockDevI := range diff.Get("ebs_block_device").(*schema.Set).List() {
+		if err := resourceAwsAmiValidateEbsBlockDeviceEncryption(ebsBlockDevI.(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resourceAwsAmiValidateEbsBlockDeviceEncryption checks that an ebs_block_device
+// does not request encryption of a volume created from a snapshot. Such a volume
+// inherits the encryption state and KMS key of the snapshot, and RegisterImage
+// rejects the combination.
+func resourceAwsAmiValidateEbsBlockDeviceEncryption(ebsBlockDev map[string]interface{}) error {
+	if ebsBlockDev["snapshot_id"].(string) == "" || !ebsBlockDev["encrypted"].(bool) {
+		return nil
+	}
+
+	return fmt.Errorf("ebs_block_device %q: encrypted cannot be set together with snapshot_id; the volume inherits the encryption and KMS key of the snapshot", ebsBlockDev["device_name"].(string))
+}
+
+func resourceAwsAmiCustomizeDiffEbsVolumeSize(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("ebs_block_device") || !diff.NewValueKnown("ebs_block_device") {
+		return nil
+	}
+
+	conn := meta.(*AWSClient).ec2conn
+
+	// RegisterImage rejects a volume_size smaller than the snapshot it is
+	// created from, so catch that at plan time rather than during apply.
+	for _, ebsBlockDevI := range diff.Get("ebs_block_device").(*schema.Set).List() {
+		ebsBlockDev := ebsBlockDevI.(map[string]interface{})
+		snapshotId := ebsBlockDev["snapshot_id"].(string)
+		volumeSize := ebsBlockDev["volume_size"].(int)
+
+		if snapshotId == "" || volumeSize == 0 {
+			continue
+		}
+
+		output, err := conn.DescribeSnapshots(&ec2.DescribeSnapshotsInput{
+			SnapshotIds: aws.StringSlice([]string{snapshotId}),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error reading EBS snapshot (%s): %w", snapshotId, err)
+		}
+
+		if output == nil || len(output.Snapshots) == 0 || output.Snapshots[0] == nil {
+			return fmt.Errorf("EBS snapshot (%s) not found", snapshotId)
+		}
+
+		if snapshotSize := aws.Int64Value(output.Snapshots[0].VolumeSize); int64(volumeSize) < snapshotSize {
+			return fmt.Errorf("ebs_block_device %q: volume_size (%d GiB) must be at least the size of snapshot %s (%d GiB)", ebsBlockDev["device_name"].(string), volumeSize, snapshotId, snapshotSize)
+		}
+	}
+
+	return nil
+}
+
+// resourceAwsAmiIsPublic returns whether the AMI's launch permissions include the "all" group.
+func resourceAwsAmiIsPublic(client *ec2.EC2, id string) (bool, error) {
+	output, err := client.DescribeImageAttribute(&ec2.DescribeImageAttributeInput{
+		Attribute: aws.String(ec2.ImageAttributeNameLaunchPermission),
+		ImageId:   aws.String(id),
+	})
+
+	if err != nil {
+		return false, fmt.Errorf("error reading AMI (%s) launch permissions: %w", id, err)
+	}
+
+	for _, lp := range output.LaunchPermissions {
+		if aws.StringValue(lp.Group) == ec2.PermissionGroupAll {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// resourceAwsAmiSetPublic adds or removes the "all" group launch permission on the AMI.
+func resourceAwsAmiSetPublic(client *ec2.EC2, id string, public bool) error {
+	permission := []*ec2.LaunchPermission{
+		{Group: aws.String(ec2.PermissionGroupAll)},
+	}
+
+	modifications := &ec2.LaunchPermissionModifications{}
+
+	if public {
+		modifications.Add = permission
+	} else {
+		modifications.Remove = permission
+	}
+
+	_, err := client.ModifyImageAttribute(&ec2.ModifyImageAttributeInput{
+		Attribute:        aws.String(ec2.ImageAttributeNameLaunchPermission),
+		ImageId:          aws.String(id),
+		LaunchPermission: modifications,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error modifying AMI (%s) public launch permission: %w", id, err)
+	}
+
+	return nil
+}
+
+// resourceAwsAmiFailFastRefreshFunc wraps an AMI state refresh function so that
+// the given terminal states end the wait immediately with the image's state
+// reason, rather than leaving the waiter to run until it times out.
+func resourceAwsAmiFailFastRefreshFunc(refresh resource.StateRefreshFunc, failureStates ...string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		v, state, err := refresh()
+		if err != nil {
+			return v, state, err
+		}
+
+		for _, failureState := range failureStates {
+			if state != failureState {
+				continue
+			}
+
+			image := v.(*ec2.Image)
+			reason := "unknown reason"
+			if image.StateReason != nil && aws.StringValue(image.StateReason.Message) != "" {
+				reason = aws.StringValue(image.StateReason.Message)
+			}
+
+			return v, state, fmt.Errorf("AMI (%s) entered %s state: %s", aws.StringValue(image.ImageId), state, reason)
+		}
+
+		return v, state, nil
+	}
+}
+
 func AMIStateRefreshFunc(client *ec2.EC2, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		emptyResp := &ec2.DescribeImagesOutput{}
 
-		resp, err := client.DescribeImages(&ec2.DescribeImagesInput{ImageIds: []*string{aws.String(id)}})
+		// The SDK already retries throttled requests with backoff and jitter.
+		// If that is exhausted, pause briefly and try again a bounded number
+		// of times rather than failing the whole wait on one throttled poll.
+		images, err := resourceAwsAmiDescribeImages(client, id)
+		for i, pause := 0, AWSAMIRefreshThrottleDelay; i < AWSAMIRefreshThrottleRetries && isAWSErr(err, "RequestLimitExceeded", ""); i, pause = i+1, pause*2 {
+			jittered := pause/2 + time.Duration(rand.Int63n(int64(pause)))
+			log.Printf("[WARN] Throttled while refreshing AMI (%s) state, retrying in %s: %s", id, jittered, err)
+			time.Sleep(jittered)
+			images, err = resourceAwsAmiDescribeImages(client, id)
+		}
+
 		if err != nil {
+			if isAWSErr(err, "RequestLimitExceeded", "") {
+				return emptyResp, "", fmt.Errorf("error refreshing AMI (%s) state: still throttled after %d retries: %w", id, AWSAMIRefreshThrottleRetries, err)
+			}
+
 			if isAWSErr(err, "InvalidAMIID.NotFound", "") {
 				return emptyResp, "destroyed", nil
-			} else if resp != nil && len(resp.Images) == 0 {
-				return emptyResp, "destroyed", nil
-			} else {
-				return emptyResp, "", fmt.Errorf("Error on refresh: %+v", err)
 			}
+
+			return emptyResp, "", fmt.Errorf("Error on refresh: %+v", err)
 		}
 
-		if resp == nil || resp.Images == nil || len(resp.Images) == 0 {
+		if len(images) == 0 {
 			return emptyResp, "destroyed", nil
 		}
 
+		if len(images) > 1 {
+			return emptyResp, "", fmt.Errorf("error refreshing AMI (%s) state: expected 1 image, found %d", id, len(images))
+		}
+
 		// AMI is valid, so return it's state
-		return resp.Images[0], *resp.Images[0].State, nil
+		return images[0], aws.StringValue(images[0].State), nil
+	}
+}
+
+// resourceAwsAmiDescribeImages returns every image DescribeImages reports
+// for the given AMI ID, skipping nil entries. Callers decide how to treat a
+// result that is not exactly one image. DescribeImages is not paginated in
+// this version of the EC2 API, so a single call returns the full result.
+func resourceAwsAmiDescribeImages(conn *ec2.EC2, id string) ([]*ec2.Image, error) {
+	output, err := conn.DescribeImages(&ec2.DescribeImagesInput{
+		ImageIds: aws.StringSlice([]string{id}),
+	})
+
+	if err != nil {
+		return nil, err
 	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	var images []*ec2.Image
+	for _, image := range output.Images {
+		if image != nil {
+			images = append(images, image)
+		}
+	}
+
+	return images, nil
+}
+
+// resourceAwsAmiWaitMinTimeout returns the minimum interval between
+// DescribeImages polls for a wait bounded by the given timeout. Polling about
+// a hundred times over the timeout keeps long waits, e.g. for large images,
+// from being throttled when many AMIs are registered in parallel.
+func resourceAwsAmiWaitMinTimeout(timeout time.Duration) time.Duration {
+	minTimeout := timeout / 100
+
+	if minTimeout < AWSAMIRetryMinTimeout {
+		return AWSAMIRetryMinTimeout
+	}
+
+	if minTimeout > AWSAMIRetryMaxMinTimeout {
+		return AWSAMIRetryMaxMinTimeout
+	}
+
+	return minTimeout
 }
 
 func resourceAwsAmiWaitForDestroy(timeout time.Duration, id string, client *ec2.EC2) error {
 	log.Printf("Waiting for AMI %s to be deleted...", id)
 
 	stateConf := &resource.StateChangeConf{
-		Pending:    []string{ec2.ImageStateAvailable, ec2.ImageStatePending, ec2.ImageStateFailed},
+		Pending:    []string{ec2.ImageStateAvailable, ec2.ImageStatePending, ec2.ImageStateTransient, ec2.ImageStateFailed},
 		Target:     []string{"destroyed"},
-		Refresh:    AMIStateRefreshFunc(client, id),
+		Refresh:    resourceAwsAmiFailFastRefreshFunc(AMIStateRefreshFunc(client, id), ec2.ImageStateInvalid, ec2.ImageStateError),
 		Timeout:    timeout,
 		Delay:      AWSAMIRetryDelay,
-		MinTimeout: AWSAMIRetryMinTimeout,
+		MinTimeout: resourceAwsAmiWaitMinTimeout(timeout),
 	}
 
 	_, err := stateConf.WaitForState()
@@ -561,12 +1119,12 @@ func resourceAwsAmiWaitForAvailable(timeout time.Duration, id string, client *ec
 	log.Printf("Waiting for AMI %s to become available...", id)
 
 	stateConf := &resource.StateChangeConf{
-		Pending:    []string{ec2.ImageStatePending},
+		Pending:    []string{ec2.ImageStatePending, ec2.ImageStateTransient},
 		Target:     []string{ec2.ImageStateAvailable},
-		Refresh:    AMIStateRefreshFunc(client, id),
+		Refresh:    resourceAwsAmiFailFastRefreshFunc(AMIStateRefreshFunc(client, id), ec2.ImageStateFailed, ec2.ImageStateInvalid, ec2.ImageStateError),
 		Timeout:    timeout,
 		Delay:      AWSAMIRetryDelay,
-		MinTimeout: AWSAMIRetryMinTimeout,
+		MinTimeout: resourceAwsAmiWaitMinTimeout(timeout),
 	}
 
 	info, err := stateConf.WaitForState()
@@ -575,3 +1133,10 @@ func resourceAwsAmiWaitForAvailable(timeout time.Duration, id string, client *ec
 	}
 	return info.(*ec2.Image), nil
 }
+
+// resourceAwsAmiDiffSuppressEmptyId treats an unset or empty kernel_id/ramdisk_id
+// as matching whatever the image reports. HVM images have no kernel or ramdisk,
+// while imported paravirtual images carry the ones they were registered with.
+func resourceAwsAmiDiffSuppressEmptyId(k, old, new string, d *schema.ResourceData) bool {
+	return new == ""
+}
@@ -15,8 +15,11 @@ func resourceAwsAmiCopy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsAmiCopyCreate,
 
+		CustomizeDiff: setTagsDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(AWSAMIRetryTimeout),
+			Read:   schema.DefaultTimeout(AWSAMIReadRetryTimeout),
 			Update: schema.DefaultTimeout(AWSAMIRetryTimeout),
 			Delete: schema.DefaultTimeout(AWSAMIDeleteRetryTimeout),
 		},
@@ -26,6 +29,10 @@ func resourceAwsAmiCopy() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -62,6 +69,11 @@ func resourceAwsAmiCopy() *schema.Resource {
 							Computed: true,
 						},
 
+						"kms_key_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
 						"snapshot_id": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -150,6 +162,27 @@ func resourceAwsAmiCopy() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"public": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"product_codes": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Set:      amiProductCodesHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"product_code_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"product_code_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"ramdisk_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -158,6 +191,10 @@ func resourceAwsAmiCopy() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"root_device_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"root_snapshot_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -176,7 +213,12 @@ func resourceAwsAmiCopy() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"state_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 			"virtualization_type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -219,8 +261,11 @@ func resourceAwsAmiCopyCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(id)
 	d.Set("manage_ebs_snapshots", true)
 
-	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
-		if err := keyvaluetags.Ec2CreateTags(client, id, v); err != nil {
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		if err := keyvaluetags.Ec2CreateTags(client, id, tags.IgnoreAws().Map()); err != nil {
 			return fmt.Errorf("error adding tags: %s", err)
 		}
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func TestAccAWSAMICopy_basic(t *testing.T) {
@@ -28,6 +29,7 @@ func TestAccAWSAMICopy_basic(t *testing.T) {
 					testAccCheckAWSAMICopyExists(resourceName, &image),
 					testAccCheckAWSAMICopyAttributes(&image, rName),
 					testAccMatchResourceAttrRegionalARNNoAccount(resourceName, "arn", "ec2", regexp.MustCompile(`image/ami-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "product_codes.#", "0"),
 				),
 			},
 		},
@@ -110,6 +112,8 @@ func TestAccAWSAMICopy_tags(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+					testAccCheckAWSAMICopySnapshotTag(&ami, "key1", "value1updated"),
+					testAccCheckAWSAMICopySnapshotTag(&ami, "key2", "value2"),
 				),
 			},
 			{
@@ -211,6 +215,36 @@ func testAccCheckAWSAMICopyAttributes(image *ec2.Image, expectedName string) res
 	}
 }
 
+func testAccCheckAWSAMICopySnapshotTag(image *ec2.Image, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		for _, bdm := range image.BlockDeviceMappings {
+			if bdm.Ebs == nil || bdm.Ebs.SnapshotId == nil {
+				continue
+			}
+
+			output, err := conn.DescribeSnapshots(&ec2.DescribeSnapshotsInput{
+				SnapshotIds: []*string{bdm.Ebs.SnapshotId},
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(output.Snapshots) == 0 {
+				return fmt.Errorf("EBS snapshot %q not found", aws.StringValue(bdm.Ebs.SnapshotId))
+			}
+
+			tags := keyvaluetags.Ec2KeyValueTags(output.Snapshots[0].Tags).Map()
+			if got := tags[key]; got != value {
+				return fmt.Errorf("EBS snapshot %q tag %q: expected %q, got %q", aws.StringValue(bdm.Ebs.SnapshotId), key, value, got)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccAWSAMICopyConfigBase(rName string) string {
 	return fmt.Sprintf(`
 data "aws_availability_zones" "available" {
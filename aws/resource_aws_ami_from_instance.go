@@ -3,9 +3,12 @@ package aws
 import (
 	"bytes"
 	"fmt"
+	"log"
+	"sync"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
@@ -15,8 +18,11 @@ func resourceAwsAmiFromInstance() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsAmiFromInstanceCreate,
 
+		CustomizeDiff: setTagsDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(AWSAMIRetryTimeout),
+			Read:   schema.DefaultTimeout(AWSAMIReadRetryTimeout),
 			Update: schema.DefaultTimeout(AWSAMIRetryTimeout),
 			Delete: schema.DefaultTimeout(AWSAMIDeleteRetryTimeout),
 		},
@@ -26,6 +32,10 @@ func resourceAwsAmiFromInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -62,6 +72,11 @@ func resourceAwsAmiFromInstance() *schema.Resource {
 							Computed: true,
 						},
 
+						"kms_key_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
 						"snapshot_id": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -90,6 +105,12 @@ func resourceAwsAmiFromInstance() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"encrypted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
 			"ephemeral_block_device": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -124,6 +145,13 @@ func resourceAwsAmiFromInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+				RequiredWith: []string{"encrypted"},
+			},
 			// Not a public attribute; used to let the aws_ami_copy and aws_ami_from_instance
 			// resources record that they implicitly created new EBS snapshots that we should
 			// now manage. Not set by aws_ami, since the snapshots used there are presumed to
@@ -137,6 +165,27 @@ func resourceAwsAmiFromInstance() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"public": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"product_codes": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Set:      amiProductCodesHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"product_code_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"product_code_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"ramdisk_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -145,10 +194,22 @@ func resourceAwsAmiFromInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"root_device_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"root_snapshot_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"source_ami_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_ami_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"source_instance_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -163,7 +224,12 @@ func resourceAwsAmiFromInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"state_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 			"virtualization_type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -185,10 +251,32 @@ func resourceAwsAmiFromInstance() *schema.Resource {
 func resourceAwsAmiFromInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AWSClient).ec2conn
 
+	name := d.Get("name").(string)
+	encrypted := d.Get("encrypted").(bool)
+
+	// CreateImage cannot change the encryption of the instance's volumes, so
+	// an encrypted image is produced by copying an intermediate image.
+	if encrypted {
+		name = resource.PrefixedUniqueId(fmt.Sprintf("%s-", name))
+	}
+
+	// Record the image the source instance was launched from, so that the
+	// lineage of the new image is available in the same attributes as for
+	// aws_ami_copy.
+	sourceInstanceId := d.Get("source_instance_id").(string)
+	instance, err := resourceAwsInstanceFindByID(client, sourceInstanceId)
+	if err != nil {
+		return fmt.Errorf("error reading source instance (%s): %s", sourceInstanceId, err)
+	}
+	if instance != nil {
+		d.Set("source_ami_id", instance.ImageId)
+		d.Set("source_ami_region", meta.(*AWSClient).region)
+	}
+
 	req := &ec2.CreateImageInput{
-		Name:        aws.String(d.Get("name").(string)),
+		Name:        aws.String(name),
 		Description: aws.String(d.Get("description").(string)),
-		InstanceId:  aws.String(d.Get("source_instance_id").(string)),
+		InstanceId:  aws.String(sourceInstanceId),
 		NoReboot:    aws.Bool(d.Get("snapshot_without_reboot").(bool)),
 	}
 
@@ -198,11 +286,22 @@ func resourceAwsAmiFromInstanceCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	id := *res.ImageId
+
+	if encrypted {
+		id, err = resourceAwsAmiFromInstanceEncrypt(d, client, id)
+		if err != nil {
+			return err
+		}
+	}
+
 	d.SetId(id)
 	d.Set("manage_ebs_snapshots", true)
 
-	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
-		if err := keyvaluetags.Ec2CreateTags(client, id, v); err != nil {
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		if err := keyvaluetags.Ec2CreateTags(client, id, tags.IgnoreAws().Map()); err != nil {
 			return fmt.Errorf("error adding tags: %s", err)
 		}
 	}
@@ -214,3 +313,114 @@ func resourceAwsAmiFromInstanceCreate(d *schema.ResourceData, meta interface{})
 
 	return resourceAwsAmiRead(d, meta)
 }
+
+// resourceAwsAmiFromInstanceEncrypt copies the intermediate image with the
+// given ID into an encrypted image, then deregisters the intermediate image
+// and deletes its snapshots. It returns the ID of the encrypted image, which
+// is recorded as the resource ID as soon as the copy has been requested.
+func resourceAwsAmiFromInstanceEncrypt(d *schema.ResourceData, client *ec2.EC2, intermediateId string) (string, error) {
+	if _, err := resourceAwsAmiWaitForAvailable(d.Timeout(schema.TimeoutCreate), intermediateId, client); err != nil {
+		resourceAwsAmiFromInstanceDeleteIntermediate(client, intermediateId)
+		return "", err
+	}
+
+	req := &ec2.CopyImageInput{
+		Name:          aws.String(d.Get("name").(string)),
+		Description:   aws.String(d.Get("description").(string)),
+		SourceImageId: aws.String(intermediateId),
+		SourceRegion:  client.Config.Region,
+		Encrypted:     aws.Bool(true),
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		req.KmsKeyId = aws.String(v.(string))
+	}
+
+	res, err := client.CopyImage(req)
+	if err != nil {
+		resourceAwsAmiFromInstanceDeleteIntermediate(client, intermediateId)
+		return "", fmt.Errorf("error copying intermediate AMI (%s) with encryption: %s", intermediateId, err)
+	}
+
+	id := aws.StringValue(res.ImageId)
+	d.SetId(id)
+	d.Set("manage_ebs_snapshots", true)
+
+	// The copy must complete before the intermediate image can be removed.
+	_, err = resourceAwsAmiWaitForAvailable(d.Timeout(schema.TimeoutCreate), id, client)
+
+	resourceAwsAmiFromInstanceDeleteIntermediate(client, intermediateId)
+
+	if err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+// resourceAwsAmiFromInstanceDeleteIntermediate deregisters the intermediate
+// image created for an encrypted copy and deletes its snapshots concurrently.
+// The image is no longer tracked by Terraform, so failures are logged rather
+// than returned.
+func resourceAwsAmiFromInstanceDeleteIntermediate(client *ec2.EC2, intermediateId string) {
+	// The snapshots must be looked up before the image is deregistered.
+	images, err := resourceAwsAmiDescribeImages(client, intermediateId)
+	if err != nil && !isAWSErr(err, "InvalidAMIID.NotFound", "") {
+		log.Printf("[WARN] Error reading intermediate AMI (%s), it must be removed manually: %s", intermediateId, err)
+		return
+	}
+
+	_, err = client.DeregisterImage(&ec2.DeregisterImageInput{
+		ImageId: aws.String(intermediateId),
+	})
+	if err != nil && !isAWSErr(err, "InvalidAMIID.NotFound", "") && !isAWSErr(err, "InvalidAMIID.Unavailable", "") {
+		log.Printf("[WARN] Error deregistering intermediate AMI (%s), it must be removed manually: %s", intermediateId, err)
+		return
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, AWSAMISnapshotDeleteConcurrency)
+
+	for _, image := range images {
+		for _, blockDev := range image.BlockDeviceMappings {
+			if blockDev.Ebs == nil || blockDev.Ebs.SnapshotId == nil {
+				continue
+			}
+
+			wg.Add(1)
+			sem <- struct{}{}
+
+			go func(snapshotId string) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+
+				input := &ec2.DeleteSnapshotInput{
+					SnapshotId: aws.String(snapshotId),
+				}
+
+				// The snapshot can remain associated with the image for a
+				// short while after it has been deregistered.
+				err := resource.Retry(AWSAMIIntermediateSnapshotDeleteTimeout, func() *resource.RetryError {
+					_, err := client.DeleteSnapshot(input)
+					if isAWSErr(err, "InvalidSnapshot.InUse", "") {
+						return resource.RetryableError(err)
+					}
+					if err != nil {
+						return resource.NonRetryableError(err)
+					}
+					return nil
+				})
+				if isResourceTimeoutError(err) {
+					_, err = client.DeleteSnapshot(input)
+				}
+				if err != nil && !isAWSErr(err, "InvalidSnapshot.NotFound", "") {
+					log.Printf("[WARN] Error deleting intermediate AMI (%s) snapshot (%s), it must be removed manually: %s", intermediateId, snapshotId, err)
+				}
+			}(aws.StringValue(blockDev.Ebs.SnapshotId))
+		}
+	}
+
+	wg.Wait()
+}
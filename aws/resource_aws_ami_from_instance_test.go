@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfawsresource"
 )
 
 func TestAccAWSAMIFromInstance_basic(t *testing.T) {
@@ -28,6 +29,58 @@ func TestAccAWSAMIFromInstance_basic(t *testing.T) {
 					testAccCheckAWSAMIFromInstanceExists(resourceName, &image),
 					testAccMatchResourceAttrRegionalARNNoAccount(resourceName, "arn", "ec2", regexp.MustCompile(`image/ami-.+`)),
 					resource.TestCheckResourceAttr(resourceName, "description", "Testing Terraform aws_ami_from_instance resource"),
+					resource.TestCheckResourceAttr(resourceName, "product_codes.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_ami_id", "aws_instance.test", "ami"),
+					resource.TestCheckResourceAttr(resourceName, "source_ami_region", testAccGetRegion()),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAMIFromInstance_encrypted(t *testing.T) {
+	var image ec2.Image
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ami_from_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAMIFromInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAMIFromInstanceConfigEncrypted(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAMIFromInstanceExists(resourceName, &image),
+					resource.TestCheckResourceAttr(resourceName, "encrypted", "true"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					tfawsresource.TestCheckTypeSetElemNestedAttrs(resourceName, "ebs_block_device.*", map[string]string{
+						"encrypted": "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAMIFromInstance_kmsKeyId(t *testing.T) {
+	var image ec2.Image
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ami_from_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAMIFromInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAMIFromInstanceConfigKmsKeyId(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAMIFromInstanceExists(resourceName, &image),
+					resource.TestCheckResourceAttr(resourceName, "encrypted", "true"),
+					tfawsresource.TestMatchTypeSetElemNestedAttrs(resourceName, "ebs_block_device.*", map[string]*regexp.Regexp{
+						"kms_key_id": regexp.MustCompile(`^arn:[^:]+:kms:[^:]+:\d{12}:key/.+$`),
+					}),
 				),
 			},
 		},
@@ -157,6 +210,38 @@ resource "aws_ami_from_instance" "test" {
 `, rName))
 }
 
+func testAccAWSAMIFromInstanceConfigEncrypted(rName string) string {
+	return composeConfig(
+		testAccAWSAMIFromInstanceConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_ami_from_instance" "test" {
+  name               = %[1]q
+  description        = "Testing Terraform aws_ami_from_instance resource"
+  encrypted          = true
+  source_instance_id = "${aws_instance.test.id}"
+}
+`, rName))
+}
+
+func testAccAWSAMIFromInstanceConfigKmsKeyId(rName string) string {
+	return composeConfig(
+		testAccAWSAMIFromInstanceConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_ami_from_instance" "test" {
+  name               = %[1]q
+  description        = "Testing Terraform aws_ami_from_instance resource"
+  encrypted          = true
+  kms_key_id         = aws_kms_key.test.arn
+  source_instance_id = "${aws_instance.test.id}"
+}
+`, rName))
+}
+
 func testAccAWSAMIFromInstanceConfigTags1(rName, tagKey1, tagValue1 string) string {
 	return composeConfig(
 		testAccAWSAMIFromInstanceConfigBase(rName),
@@ -14,6 +14,36 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func TestResourceAwsAmiWaitMinTimeout(t *testing.T) {
+	cases := []struct {
+		timeout  time.Duration
+		expected time.Duration
+	}{
+		{
+			timeout:  1 * time.Minute,
+			expected: AWSAMIRetryMinTimeout,
+		},
+		{
+			timeout:  10 * time.Minute,
+			expected: 6 * time.Second,
+		},
+		{
+			timeout:  AWSAMIRetryTimeout,
+			expected: 24 * time.Second,
+		},
+		{
+			timeout:  AWSAMIDeleteRetryTimeout,
+			expected: AWSAMIRetryMaxMinTimeout,
+		},
+	}
+
+	for _, c := range cases {
+		if actual := resourceAwsAmiWaitMinTimeout(c.timeout); actual != c.expected {
+			t.Errorf("timeout %s: expected %s, got %s", c.timeout, c.expected, actual)
+		}
+	}
+}
+
 func TestAccAWSAMI_basic(t *testing.T) {
 	var ami ec2.Image
 	resourceName := "aws_ami.test"
@@ -28,10 +58,15 @@ func TestAccAWSAMI_basic(t *testing.T) {
 				Config: testAccAmiConfigBasic(rName, 8),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAmiExists(resourceName, &ami),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
 					resource.TestCheckResourceAttr(resourceName, "ena_support", "true"),
+					resource.TestCheckResourceAttr(resourceName, "encrypted", "false"),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "product_codes.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "public", "false"),
 					testAccMatchResourceAttrRegionalARNNoAccount(resourceName, "arn", "ec2", regexp.MustCompile(`image/ami-.+`)),
 					resource.TestCheckResourceAttr(resourceName, "root_device_name", "/dev/sda1"),
+					resource.TestCheckResourceAttr(resourceName, "root_device_type", "ebs"),
 					resource.TestCheckResourceAttr(resourceName, "virtualization_type", "hvm"),
 					resource.TestMatchResourceAttr(resourceName, "root_snapshot_id", regexp.MustCompile("^snap-")),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
@@ -42,6 +77,7 @@ func TestAccAWSAMI_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
+					"keep_on_failure",
 					"manage_ebs_snapshots",
 				},
 			},
@@ -49,6 +85,160 @@ func TestAccAWSAMI_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSAMI_namePrefix(t *testing.T) {
+	var ami ec2.Image
+	resourceName := "aws_ami.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAmiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAmiConfigNamePrefix(rName, 8),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAmiExists(resourceName, &ami),
+					resource.TestMatchResourceAttr(resourceName, "name", regexp.MustCompile(fmt.Sprintf("^%s-", rName))),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", fmt.Sprintf("%s-", rName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"keep_on_failure",
+					"manage_ebs_snapshots",
+					"name_prefix",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAWSAMI_duplicateName(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAmiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAmiConfigDuplicateName(rName, 8),
+				ExpectError: regexp.MustCompile(`use name_prefix to generate a unique name`),
+			},
+		},
+	})
+}
+
+func TestAccAWSAMI_emptyKernelRamdisk(t *testing.T) {
+	var ami ec2.Image
+	resourceName := "aws_ami.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAmiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAmiConfigEmptyKernelRamdisk(rName, 8),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAmiExists(resourceName, &ami),
+					resource.TestCheckResourceAttr(resourceName, "kernel_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "ramdisk_id", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"keep_on_failure",
+					"manage_ebs_snapshots",
+				},
+			},
+			{
+				Config:   testAccAmiConfigBasic(rName, 8),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAMI_public(t *testing.T) {
+	var ami ec2.Image
+	resourceName := "aws_ami.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAmiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAmiConfigPublic(rName, 8, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAmiExists(resourceName, &ami),
+					resource.TestCheckResourceAttr(resourceName, "public", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"keep_on_failure",
+					"manage_ebs_snapshots",
+				},
+			},
+			{
+				Config: testAccAmiConfigPublic(rName, 8, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAmiExists(resourceName, &ami),
+					resource.TestCheckResourceAttr(resourceName, "public", "false"),
+				),
+			},
+			{
+				PreConfig: func() {
+					conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+					if err := resourceAwsAmiSetPublic(conn, aws.StringValue(ami.ImageId), true); err != nil {
+						t.Fatalf("error making AMI public: %s", err)
+					}
+				},
+				Config:             testAccAmiConfigPublic(rName, 8, false),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAmiConfigPublic(rName, 8, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAmiExists(resourceName, &ami),
+					resource.TestCheckResourceAttr(resourceName, "public", "false"),
+				),
+			},
+			{
+				// Launch permissions are left alone when public is not configured.
+				PreConfig: func() {
+					conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+					if err := resourceAwsAmiSetPublic(conn, aws.StringValue(ami.ImageId), true); err != nil {
+						t.Fatalf("error making AMI public: %s", err)
+					}
+				},
+				Config: testAccAmiConfigBasic(rName, 8),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAmiExists(resourceName, &ami),
+					resource.TestCheckResourceAttr(resourceName, "public", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSAMI_description(t *testing.T) {
 	var ami ec2.Image
 	resourceName := "aws_ami.test"
@@ -73,6 +263,7 @@ func TestAccAWSAMI_description(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
+					"keep_on_failure",
 					"manage_ebs_snapshots",
 				},
 			},
@@ -125,6 +316,8 @@ func TestAccAWSAMI_tags(t *testing.T) {
 					testAccCheckAmiExists(resourceName, &ami),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.key1", "value1"),
 				),
 			},
 			{
@@ -132,6 +325,7 @@ func TestAccAWSAMI_tags(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
+					"keep_on_failure",
 					"manage_ebs_snapshots",
 				},
 			},
@@ -190,6 +384,48 @@ func TestAccAWSAMI_snapshotSize(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
+					"keep_on_failure",
+					"manage_ebs_snapshots",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAWSAMI_volumeTypeIo2(t *testing.T) {
+	var ami ec2.Image
+	var bd ec2.BlockDeviceMapping
+	resourceName := "aws_ami.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	expectedDevice := &ec2.EbsBlockDevice{
+		DeleteOnTermination: aws.Bool(true),
+		Encrypted:           aws.Bool(false),
+		Iops:                aws.Int64(1000),
+		VolumeSize:          aws.Int64(8),
+		VolumeType:          aws.String(ec2.VolumeTypeIo2),
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAmiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAmiConfigVolumeTypeIo2(rName, 8, 1000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAmiExists(resourceName, &ami),
+					testAccCheckAmiBlockDevice(&ami, &bd, "/dev/sda1"),
+					testAccCheckAmiEbsBlockDevice(&bd, expectedDevice),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"keep_on_failure",
 					"manage_ebs_snapshots",
 				},
 			},
@@ -197,6 +433,113 @@ func TestAccAWSAMI_snapshotSize(t *testing.T) {
 	})
 }
 
+func TestAccAWSAMI_volumeSizeSmallerThanSnapshot(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAmiDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The snapshot must exist before the plan-time size check can run.
+				Config: testAccAmiConfigBase(rName, 20),
+			},
+			{
+				Config:      testAccAmiConfigVolumeSize(rName, 20, 8),
+				ExpectError: regexp.MustCompile(`volume_size \(8 GiB\) must be at least the size of snapshot`),
+			},
+		},
+	})
+}
+
+func TestAccAWSAMI_encryptedSnapshotValidation(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAmiDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The snapshot must exist for its ID to be known at plan time.
+				Config: testAccAmiConfigBase(rName, 8),
+			},
+			{
+				Config:      testAccAmiConfigEncryptedSnapshot(rName),
+				ExpectError: regexp.MustCompile(`ebs_block_device "/dev/sda1": encrypted cannot be set together with snapshot_id`),
+			},
+		},
+	})
+}
+
+func TestAccAWSAMI_ephemeralBlockDeviceValidation(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAmiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAmiConfigEphemeralBlockDevices(rName, "/dev/sdb", "ephemral0", "/dev/sdc", "ephemeral1"),
+				ExpectError: regexp.MustCompile(`must be in the format ephemeralN`),
+			},
+			{
+				Config:      testAccAmiConfigEphemeralBlockDevices(rName, "/dev/sdb", "ephemeral0", "/dev/sdb", "ephemeral1"),
+				ExpectError: regexp.MustCompile(`device_name "/dev/sdb" is used by more than one block device`),
+			},
+			{
+				Config:      testAccAmiConfigEphemeralBlockDevices(rName, "/dev/sda1", "ephemeral0", "/dev/sdc", "ephemeral1"),
+				ExpectError: regexp.MustCompile(`device_name "/dev/sda1" is used by more than one block device`),
+			},
+		},
+	})
+}
+
+func TestAccAWSAMI_defaultTags(t *testing.T) {
+	var ami ec2.Image
+	resourceName := "aws_ami.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAmiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfigDefaultTagsTags1("defaultkey1", "defaultvalue1") + testAccAmiConfigTags1(rName, "key1", "value1", 8),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAmiExists(resourceName, &ami),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.defaultkey1", "defaultvalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccProviderConfigDefaultTagsTags1("key1", "defaultvalue1") + testAccAmiConfigTags1(rName, "key1", "value1", 8),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAmiExists(resourceName, &ami),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAmiConfigTags1(rName, "key1", "value1", 8),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAmiExists(resourceName, &ami),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAmiDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).ec2conn
 
@@ -374,6 +717,85 @@ resource "aws_ami" "test" {
 `, rName)
 }
 
+func testAccAmiConfigNamePrefix(rName string, size int) string {
+	return testAccAmiConfigBase(rName, size) + fmt.Sprintf(`
+resource "aws_ami" "test" {
+  ena_support         = true
+  name_prefix         = "%[1]s-"
+  root_device_name    = "/dev/sda1"
+  virtualization_type = "hvm"
+
+  ebs_block_device {
+    device_name = "/dev/sda1"
+    snapshot_id = "${aws_ebs_snapshot.test.id}"
+  }
+}
+`, rName)
+}
+
+func testAccAmiConfigDuplicateName(rName string, size int) string {
+	return testAccAmiConfigBase(rName, size) + fmt.Sprintf(`
+resource "aws_ami" "test" {
+  ena_support         = true
+  name                = %[1]q
+  root_device_name    = "/dev/sda1"
+  virtualization_type = "hvm"
+
+  ebs_block_device {
+    device_name = "/dev/sda1"
+    snapshot_id = "${aws_ebs_snapshot.test.id}"
+  }
+}
+
+resource "aws_ami" "duplicate" {
+  ena_support         = true
+  name                = aws_ami.test.name
+  root_device_name    = "/dev/sda1"
+  virtualization_type = "hvm"
+
+  ebs_block_device {
+    device_name = "/dev/sda1"
+    snapshot_id = "${aws_ebs_snapshot.test.id}"
+  }
+}
+`, rName)
+}
+
+func testAccAmiConfigEmptyKernelRamdisk(rName string, size int) string {
+	return testAccAmiConfigBase(rName, size) + fmt.Sprintf(`
+resource "aws_ami" "test" {
+  ena_support         = true
+  kernel_id           = ""
+  name                = %[1]q
+  ramdisk_id          = ""
+  root_device_name    = "/dev/sda1"
+  virtualization_type = "hvm"
+
+  ebs_block_device {
+    device_name = "/dev/sda1"
+    snapshot_id = "${aws_ebs_snapshot.test.id}"
+  }
+}
+`, rName)
+}
+
+func testAccAmiConfigPublic(rName string, size int, public bool) string {
+	return testAccAmiConfigBase(rName, size) + fmt.Sprintf(`
+resource "aws_ami" "test" {
+  ena_support         = true
+  name                = %[1]q
+  public              = %[2]t
+  root_device_name    = "/dev/sda1"
+  virtualization_type = "hvm"
+
+  ebs_block_device {
+    device_name = "/dev/sda1"
+    snapshot_id = "${aws_ebs_snapshot.test.id}"
+  }
+}
+`, rName, public)
+}
+
 func testAccAmiConfigDesc(rName, desc string, size int) string {
 	return testAccAmiConfigBase(rName, size) + fmt.Sprintf(`
 resource "aws_ami" "test" {
@@ -431,3 +853,81 @@ resource "aws_ami" "test" {
 }
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2)
 }
+
+func testAccAmiConfigVolumeTypeIo2(rName string, size, iops int) string {
+	return testAccAmiConfigBase(rName, size) + fmt.Sprintf(`
+resource "aws_ami" "test" {
+  ena_support         = true
+  name                = %[1]q
+  root_device_name    = "/dev/sda1"
+  virtualization_type = "hvm"
+
+  ebs_block_device {
+    device_name = "/dev/sda1"
+    snapshot_id = "${aws_ebs_snapshot.test.id}"
+    volume_type = "io2"
+    iops        = %[2]d
+  }
+}
+`, rName, iops)
+}
+
+func testAccAmiConfigVolumeSize(rName string, size, volumeSize int) string {
+	return testAccAmiConfigBase(rName, size) + fmt.Sprintf(`
+resource "aws_ami" "test" {
+  ena_support         = true
+  name                = %[1]q
+  root_device_name    = "/dev/sda1"
+  virtualization_type = "hvm"
+
+  ebs_block_device {
+    device_name = "/dev/sda1"
+    snapshot_id = "${aws_ebs_snapshot.test.id}"
+    volume_size = %[2]d
+  }
+}
+`, rName, volumeSize)
+}
+
+func testAccAmiConfigEncryptedSnapshot(rName string) string {
+	return testAccAmiConfigBase(rName, 8) + fmt.Sprintf(`
+resource "aws_ami" "test" {
+  ena_support         = true
+  name                = %[1]q
+  root_device_name    = "/dev/sda1"
+  virtualization_type = "hvm"
+
+  ebs_block_device {
+    device_name = "/dev/sda1"
+    encrypted   = true
+    snapshot_id = "${aws_ebs_snapshot.test.id}"
+  }
+}
+`, rName)
+}
+
+func testAccAmiConfigEphemeralBlockDevices(rName, deviceName1, virtualName1, deviceName2, virtualName2 string) string {
+	return testAccAmiConfigBase(rName, 8) + fmt.Sprintf(`
+resource "aws_ami" "test" {
+  ena_support         = true
+  name                = %[1]q
+  root_device_name    = "/dev/sda1"
+  virtualization_type = "hvm"
+
+  ebs_block_device {
+    device_name = "/dev/sda1"
+    snapshot_id = "${aws_ebs_snapshot.test.id}"
+  }
+
+  ephemeral_block_device {
+    device_name  = %[2]q
+    virtual_name = %[3]q
+  }
+
+  ephemeral_block_device {
+    device_name  = %[4]q
+    virtual_name = %[5]q
+  }
+}
+`, rName, deviceName1, virtualName1, deviceName2, virtualName2)
+}
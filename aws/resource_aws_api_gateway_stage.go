@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -48,8 +49,9 @@ func resourceAwsApiGatewayStage() *schema.Resource {
 							Required: true,
 						},
 						"format": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateApiGatewayStageAccessLogFormat,
 						},
 					},
 				},
@@ -112,6 +114,12 @@ func resourceAwsApiGatewayStage() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"tags": tagsSchema(),
+			"web_acl_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateArn,
+			},
 			"xray_tracing_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -169,6 +177,19 @@ func resourceAwsApiGatewayStageCreate(d *schema.ResourceData, meta interface{})
 
 	d.SetId(fmt.Sprintf("ags-%s-%s", d.Get("rest_api_id").(string), d.Get("stage_name").(string)))
 
+	if v, ok := d.GetOk("web_acl_arn"); ok {
+		stageArn := arn.ARN{
+			Partition: meta.(*AWSClient).partition,
+			Region:    meta.(*AWSClient).region,
+			Service:   "apigateway",
+			Resource:  fmt.Sprintf("/restapis/%s/stages/%s", d.Get("rest_api_id").(string), d.Get("stage_name").(string)),
+		}.String()
+
+		if err := apiGatewayStageAssociateWebACL(meta.(*AWSClient).wafv2conn, stageArn, v.(string)); err != nil {
+			return fmt.Errorf("error associating API Gateway Stage (%s) with WAFv2 Web ACL (%s): %w", d.Id(), v.(string), err)
+		}
+	}
+
 	if waitForCache && *out.CacheClusterStatus != apigateway.CacheClusterStatusNotAvailable {
 		stateConf := &resource.StateChangeConf{
 			Pending: []string{
@@ -254,6 +275,8 @@ func resourceAwsApiGatewayStageRead(d *schema.ResourceData, meta interface{}) er
 	}.String()
 	d.Set("arn", stageArn)
 
+	d.Set("web_acl_arn", stage.WebAclArn)
+
 	if err := d.Set("variables", aws.StringValueMap(stage.Variables)); err != nil {
 		return fmt.Errorf("error setting variables: %s", err)
 	}
@@ -288,6 +311,24 @@ func resourceAwsApiGatewayStageUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if d.HasChange("web_acl_arn") {
+		wafv2conn := meta.(*AWSClient).wafv2conn
+
+		if v, ok := d.GetOk("web_acl_arn"); ok {
+			if err := apiGatewayStageAssociateWebACL(wafv2conn, stageArn, v.(string)); err != nil {
+				return fmt.Errorf("error associating API Gateway Stage (%s) with WAFv2 Web ACL (%s): %w", d.Id(), v.(string), err)
+			}
+		} else {
+			_, err := wafv2conn.DisassociateWebACL(&wafv2.DisassociateWebACLInput{
+				ResourceArn: aws.String(stageArn),
+			})
+
+			if err != nil {
+				return fmt.Errorf("error disassociating API Gateway Stage (%s) from WAFv2 Web ACL: %w", d.Id(), err)
+			}
+		}
+	}
+
 	operations := make([]*apigateway.PatchOperation, 0)
 	waitForCache := false
 	if d.HasChange("cache_cluster_enabled") {
@@ -453,6 +494,34 @@ func apiGatewayStageCacheRefreshFunc(conn *apigateway.APIGateway, apiId, stageNa
 	}
 }
 
+func apiGatewayStageAssociateWebACL(conn *wafv2.WAFV2, stageArn, webAclArn string) error {
+	input := &wafv2.AssociateWebACLInput{
+		ResourceArn: aws.String(stageArn),
+		WebACLArn:   aws.String(webAclArn),
+	}
+
+	// The stage may not yet be visible to WAFv2 immediately after creation.
+	err := resource.Retry(Wafv2WebACLAssociationCreateTimeout, func() *resource.RetryError {
+		_, err := conn.AssociateWebACL(input)
+
+		if isAWSErr(err, wafv2.ErrCodeWAFUnavailableEntityException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.AssociateWebACL(input)
+	}
+
+	return err
+}
+
 func resourceAwsApiGatewayStageDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigatewayconn
 	log.Printf("[DEBUG] Deleting API Gateway Stage: %s", d.Id())
@@ -66,6 +66,22 @@ func resourceAwsApiGatewayV2Route() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"request_parameter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"request_parameter_key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"required": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
 			"route_key": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -107,6 +123,9 @@ func resourceAwsApiGatewayV2RouteCreate(d *schema.ResourceData, meta interface{}
 	if v, ok := d.GetOk("request_models"); ok {
 		req.RequestModels = stringMapToPointers(v.(map[string]interface{}))
 	}
+	if v, ok := d.GetOk("request_parameter"); ok && v.(*schema.Set).Len() > 0 {
+		req.RequestParameters = expandApiGatewayV2RouteRequestParameters(v.(*schema.Set))
+	}
 	if v, ok := d.GetOk("route_response_selection_expression"); ok {
 		req.RouteResponseSelectionExpression = aws.String(v.(string))
 	}
@@ -152,6 +171,9 @@ func resourceAwsApiGatewayV2RouteRead(d *schema.ResourceData, meta interface{})
 	if err := d.Set("request_models", pointersMapToStringList(resp.RequestModels)); err != nil {
 		return fmt.Errorf("error setting request_models: %s", err)
 	}
+	if err := d.Set("request_parameter", flattenApiGatewayV2RouteRequestParameters(resp.RequestParameters)); err != nil {
+		return fmt.Errorf("error setting request_parameter: %s", err)
+	}
 	d.Set("route_key", resp.RouteKey)
 	d.Set("route_response_selection_expression", resp.RouteResponseSelectionExpression)
 	d.Set("target", resp.Target)
@@ -162,6 +184,33 @@ func resourceAwsApiGatewayV2RouteRead(d *schema.ResourceData, meta interface{})
 func resourceAwsApiGatewayV2RouteUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigatewayv2conn
 
+	if d.HasChange("request_parameter") {
+		o, n := d.GetChange("request_parameter")
+		oParameters := expandApiGatewayV2RouteRequestParameters(o.(*schema.Set))
+		nParameters := expandApiGatewayV2RouteRequestParameters(n.(*schema.Set))
+
+		// UpdateRoute merges request parameters, so keys no longer in the
+		// configuration must be removed explicitly.
+		for key := range oParameters {
+			if _, ok := nParameters[key]; ok {
+				continue
+			}
+
+			log.Printf("[DEBUG] Deleting API Gateway v2 route (%s) request parameter (%s)", d.Id(), key)
+			_, err := conn.DeleteRouteRequestParameter(&apigatewayv2.DeleteRouteRequestParameterInput{
+				ApiId:               aws.String(d.Get("api_id").(string)),
+				RequestParameterKey: aws.String(key),
+				RouteId:             aws.String(d.Id()),
+			})
+			if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("error deleting API Gateway v2 route (%s) request parameter (%s): %s", d.Id(), key, err)
+			}
+		}
+	}
+
 	req := &apigatewayv2.UpdateRouteInput{
 		ApiId:   aws.String(d.Get("api_id").(string)),
 		RouteId: aws.String(d.Id()),
@@ -187,6 +236,9 @@ func resourceAwsApiGatewayV2RouteUpdate(d *schema.ResourceData, meta interface{}
 	if d.HasChange("request_models") {
 		req.RequestModels = stringMapToPointers(d.Get("request_models").(map[string]interface{}))
 	}
+	if d.HasChange("request_parameter") {
+		req.RequestParameters = expandApiGatewayV2RouteRequestParameters(d.Get("request_parameter").(*schema.Set))
+	}
 	if d.HasChange("route_key") {
 		req.RouteKey = aws.String(d.Get("route_key").(string))
 	}
@@ -252,3 +304,30 @@ func resourceAwsApiGatewayV2RouteImport(d *schema.ResourceData, meta interface{}
 
 	return []*schema.ResourceData{d}, nil
 }
+
+func expandApiGatewayV2RouteRequestParameters(vParameters *schema.Set) map[string]*apigatewayv2.ParameterConstraints {
+	parameters := map[string]*apigatewayv2.ParameterConstraints{}
+
+	for _, v := range vParameters.List() {
+		mParameter := v.(map[string]interface{})
+
+		parameters[mParameter["request_parameter_key"].(string)] = &apigatewayv2.ParameterConstraints{
+			Required: aws.Bool(mParameter["required"].(bool)),
+		}
+	}
+
+	return parameters
+}
+
+func flattenApiGatewayV2RouteRequestParameters(parameters map[string]*apigatewayv2.ParameterConstraints) []interface{} {
+	vParameters := []interface{}{}
+
+	for k, parameterConstraints := range parameters {
+		vParameters = append(vParameters, map[string]interface{}{
+			"request_parameter_key": k,
+			"required":              aws.BoolValue(parameterConstraints.Required),
+		})
+	}
+
+	return vParameters
+}
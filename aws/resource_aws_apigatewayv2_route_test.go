@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfawsresource"
 )
 
 func TestAccAWSAPIGatewayV2Route_basic(t *testing.T) {
@@ -218,6 +219,63 @@ func TestAccAWSAPIGatewayV2Route_Model(t *testing.T) {
 	})
 }
 
+func TestAccAWSAPIGatewayV2Route_RequestParameters(t *testing.T) {
+	var apiId string
+	var v apigatewayv2.GetRouteOutput
+	resourceName := "aws_apigatewayv2_route.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayV2RouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayV2RouteConfig_requestParameters(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2RouteExists(resourceName, &apiId, &v),
+					resource.TestCheckResourceAttr(resourceName, "request_parameter.#", "2"),
+					tfawsresource.TestCheckTypeSetElemNestedAttrs(resourceName, "request_parameter.*", map[string]string{
+						"request_parameter_key": "route.request.header.authorization",
+						"required":              "true",
+					}),
+					tfawsresource.TestCheckTypeSetElemNestedAttrs(resourceName, "request_parameter.*", map[string]string{
+						"request_parameter_key": "route.request.querystring.authToken",
+						"required":              "false",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "route_key", "$connect"),
+				),
+			},
+			{
+				Config: testAccAWSAPIGatewayV2RouteConfig_requestParametersUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2RouteExists(resourceName, &apiId, &v),
+					resource.TestCheckResourceAttr(resourceName, "request_parameter.#", "1"),
+					tfawsresource.TestCheckTypeSetElemNestedAttrs(resourceName, "request_parameter.*", map[string]string{
+						"request_parameter_key": "route.request.querystring.authToken",
+						"required":              "true",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "route_key", "$connect"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccAWSAPIGatewayV2RouteImportStateIdFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAPIGatewayV2RouteConfig_noRequestParameters(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2RouteExists(resourceName, &apiId, &v),
+					resource.TestCheckResourceAttr(resourceName, "request_parameter.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "route_key", "$connect"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSAPIGatewayV2Route_SimpleAttributes(t *testing.T) {
 	var apiId string
 	var v apigatewayv2.GetRouteOutput
@@ -254,7 +312,7 @@ func TestAccAWSAPIGatewayV2Route_SimpleAttributes(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "model_selection_expression", ""),
 					resource.TestCheckResourceAttr(resourceName, "operation_name", ""),
 					resource.TestCheckResourceAttr(resourceName, "request_models.%", "0"),
-					resource.TestCheckResourceAttr(resourceName, "request_parameters.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "request_parameter.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "route_key", "$default"),
 					resource.TestCheckResourceAttr(resourceName, "route_response_selection_expression", ""),
 					resource.TestCheckResourceAttr(resourceName, "target", ""),
@@ -567,6 +625,48 @@ resource "aws_apigatewayv2_route" "test" {
 `, routeKey))
 }
 
+func testAccAWSAPIGatewayV2RouteConfig_noRequestParameters(rName string) string {
+	return testAccAWSAPIGatewayV2RouteConfig_apiWebSocket(rName) + `
+resource "aws_apigatewayv2_route" "test" {
+  api_id    = "${aws_apigatewayv2_api.test.id}"
+  route_key = "$connect"
+}
+`
+}
+
+func testAccAWSAPIGatewayV2RouteConfig_requestParameters(rName string) string {
+	return testAccAWSAPIGatewayV2RouteConfig_apiWebSocket(rName) + `
+resource "aws_apigatewayv2_route" "test" {
+  api_id    = "${aws_apigatewayv2_api.test.id}"
+  route_key = "$connect"
+
+  request_parameter {
+    request_parameter_key = "route.request.header.authorization"
+    required              = true
+  }
+
+  request_parameter {
+    request_parameter_key = "route.request.querystring.authToken"
+    required              = false
+  }
+}
+`
+}
+
+func testAccAWSAPIGatewayV2RouteConfig_requestParametersUpdated(rName string) string {
+	return testAccAWSAPIGatewayV2RouteConfig_apiWebSocket(rName) + `
+resource "aws_apigatewayv2_route" "test" {
+  api_id    = "${aws_apigatewayv2_api.test.id}"
+  route_key = "$connect"
+
+  request_parameter {
+    request_parameter_key = "route.request.querystring.authToken"
+    required              = true
+  }
+}
+`
+}
+
 // Simple attributes - No authorization, models or targets.
 func testAccAWSAPIGatewayV2RouteConfig_simpleAttributes(rName string) string {
 	return testAccAWSAPIGatewayV2RouteConfig_apiWebSocket(rName) + `
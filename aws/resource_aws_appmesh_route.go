@@ -213,6 +213,52 @@ func resourceAwsAppmeshRoute() *schema.Resource {
 											},
 										},
 									},
+
+									"retry_policy": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MinItems: 0,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"http_retry_events": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													MinItems: 0,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+
+												"max_retries": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+
+												"per_retry_timeout": appmeshDurationSchema(true),
+
+												"tcp_retry_events": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													MinItems: 0,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+
+									"timeout": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MinItems: 0,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"idle": appmeshDurationSchema(false),
+
+												"per_request": appmeshDurationSchema(false),
+											},
+										},
+									},
 								},
 							},
 						},
@@ -487,3 +533,29 @@ func appmeshWeightedTargetHash(v interface{}) int {
 	}
 	return hashcode.String(buf.String())
 }
+
+// appmeshDurationSchema returns the schema for an App Mesh duration block.
+func appmeshDurationSchema(required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: required,
+		Optional: !required,
+		MinItems: 0,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"unit": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(appmesh.DurationUnit_Values(), false),
+				},
+
+				"value": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+			},
+		},
+	}
+}
@@ -39,7 +39,9 @@ func resourceAwsCurReportDefinition() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 				ValidateFunc: validation.StringInSlice([]string{
-					costandusagereportservice.ReportFormatTextOrcsv}, false),
+					costandusagereportservice.ReportFormatTextOrcsv,
+					costandusagereportservice.ReportFormatParquet,
+				}, false),
 			},
 			"compression": {
 				Type:     schema.TypeString,
@@ -48,6 +50,7 @@ func resourceAwsCurReportDefinition() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{
 					costandusagereportservice.CompressionFormatGzip,
 					costandusagereportservice.CompressionFormatZip,
+					costandusagereportservice.CompressionFormatParquet,
 				}, false),
 			},
 			"additional_schema_elements": {
@@ -82,6 +85,7 @@ func resourceAwsCurReportDefinition() *schema.Resource {
 				Type: schema.TypeSet,
 				Elem: &schema.Schema{Type: schema.TypeString,
 					ValidateFunc: validation.StringInSlice([]string{
+						costandusagereportservice.AdditionalArtifactAthena,
 						costandusagereportservice.AdditionalArtifactQuicksight,
 						costandusagereportservice.AdditionalArtifactRedshift,
 					}, false),
@@ -90,6 +94,22 @@ func resourceAwsCurReportDefinition() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"refresh_closed_reports": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: true,
+			},
+			"report_versioning": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  costandusagereportservice.ReportVersioningCreateNewReport,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					costandusagereportservice.ReportVersioningCreateNewReport,
+					costandusagereportservice.ReportVersioningOverwriteReport,
+				}, false),
+			},
 		},
 	}
 }
@@ -98,6 +118,17 @@ func resourceAwsCurReportDefinitionCreate(d *schema.ResourceData, meta interface
 	conn := meta.(*AWSClient).costandusagereportconn
 
 	reportName := d.Get("report_name").(string)
+	additionalArtifacts := expandStringSet(d.Get("additional_artifacts").(*schema.Set))
+
+	err := checkAwsCurReportDefinitionPropertyCombination(
+		additionalArtifacts,
+		d.Get("compression").(string),
+		d.Get("format").(string),
+		d.Get("report_versioning").(string),
+	)
+	if err != nil {
+		return err
+	}
 
 	reportDefinition := &costandusagereportservice.ReportDefinition{
 		ReportName:               aws.String(reportName),
@@ -108,7 +139,9 @@ func resourceAwsCurReportDefinitionCreate(d *schema.ResourceData, meta interface
 		S3Bucket:                 aws.String(d.Get("s3_bucket").(string)),
 		S3Prefix:                 aws.String(d.Get("s3_prefix").(string)),
 		S3Region:                 aws.String(d.Get("s3_region").(string)),
-		AdditionalArtifacts:      expandStringSet(d.Get("additional_artifacts").(*schema.Set)),
+		AdditionalArtifacts:      additionalArtifacts,
+		RefreshClosedReports:     aws.Bool(d.Get("refresh_closed_reports").(bool)),
+		ReportVersioning:         aws.String(d.Get("report_versioning").(string)),
 	}
 
 	reportDefinitionInput := &costandusagereportservice.PutReportDefinitionInput{
@@ -116,7 +149,7 @@ func resourceAwsCurReportDefinitionCreate(d *schema.ResourceData, meta interface
 	}
 	log.Printf("[DEBUG] Creating AWS Cost and Usage Report Definition : %v", reportDefinitionInput)
 
-	_, err := conn.PutReportDefinition(reportDefinitionInput)
+	_, err = conn.PutReportDefinition(reportDefinitionInput)
 	if err != nil {
 		return fmt.Errorf("Error creating AWS Cost And Usage Report Definition: %s", err)
 	}
@@ -148,6 +181,8 @@ func resourceAwsCurReportDefinitionRead(d *schema.ResourceData, meta interface{}
 	d.Set("s3_prefix", aws.StringValue(matchingReportDefinition.S3Prefix))
 	d.Set("s3_region", aws.StringValue(matchingReportDefinition.S3Region))
 	d.Set("additional_artifacts", aws.StringValueSlice(matchingReportDefinition.AdditionalArtifacts))
+	d.Set("refresh_closed_reports", matchingReportDefinition.RefreshClosedReports)
+	d.Set("report_versioning", matchingReportDefinition.ReportVersioning)
 	return nil
 }
 
@@ -183,3 +218,27 @@ func describeCurReportDefinition(conn *costandusagereportservice.CostandUsageRep
 	}
 	return matchingReportDefinition, nil
 }
+
+// checkAwsCurReportDefinitionPropertyCombination checks the combinations of
+// properties that PutReportDefinition requires for Athena integration.
+func checkAwsCurReportDefinitionPropertyCombination(additionalArtifacts []*string, compression, format, reportVersioning string) error {
+	for _, artifact := range additionalArtifacts {
+		if aws.StringValue(artifact) != costandusagereportservice.AdditionalArtifactAthena {
+			continue
+		}
+
+		if len(additionalArtifacts) > 1 {
+			return fmt.Errorf("When %s exists within additional_artifacts, no other artifact type can be declared", costandusagereportservice.AdditionalArtifactAthena)
+		}
+
+		if compression != costandusagereportservice.CompressionFormatParquet || format != costandusagereportservice.ReportFormatParquet {
+			return fmt.Errorf("When %s exists within additional_artifacts, both compression and format must be %s", costandusagereportservice.AdditionalArtifactAthena, costandusagereportservice.ReportFormatParquet)
+		}
+
+		if reportVersioning != costandusagereportservice.ReportVersioningOverwriteReport {
+			return fmt.Errorf("When %s exists within additional_artifacts, report_versioning must be %s", costandusagereportservice.AdditionalArtifactAthena, costandusagereportservice.ReportVersioningOverwriteReport)
+		}
+	}
+
+	return nil
+}
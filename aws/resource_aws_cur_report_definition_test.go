@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -10,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfawsresource"
 )
 
 func TestAccAwsCurReportDefinition_basic(t *testing.T) {
@@ -39,12 +41,70 @@ func TestAccAwsCurReportDefinition_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "s3_prefix", ""),
 					resource.TestCheckResourceAttrPair(resourceName, "s3_region", s3BucketResourceName, "region"),
 					resource.TestCheckResourceAttr(resourceName, "additional_artifacts.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "refresh_closed_reports", "true"),
+					resource.TestCheckResourceAttr(resourceName, "report_versioning", "CREATE_NEW_REPORT"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccAwsCurReportDefinition_athena(t *testing.T) {
+	oldvar := os.Getenv("AWS_DEFAULT_REGION")
+	os.Setenv("AWS_DEFAULT_REGION", "us-east-1")
+	defer os.Setenv("AWS_DEFAULT_REGION", oldvar)
+
+	resourceName := "aws_cur_report_definition.test"
+	reportName := acctest.RandomWithPrefix("tf_acc_test")
+	bucketName := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCur(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsCurReportDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsCurReportDefinitionConfig_athena(reportName, bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsCurReportDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "format", "Parquet"),
+					resource.TestCheckResourceAttr(resourceName, "compression", "Parquet"),
+					resource.TestCheckResourceAttr(resourceName, "additional_artifacts.#", "1"),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "additional_artifacts.*", "ATHENA"),
+					resource.TestCheckResourceAttr(resourceName, "refresh_closed_reports", "false"),
+					resource.TestCheckResourceAttr(resourceName, "report_versioning", "OVERWRITE_REPORT"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsCurReportDefinition_athenaInvalidCombination(t *testing.T) {
+	oldvar := os.Getenv("AWS_DEFAULT_REGION")
+	os.Setenv("AWS_DEFAULT_REGION", "us-east-1")
+	defer os.Setenv("AWS_DEFAULT_REGION", oldvar)
+
+	reportName := acctest.RandomWithPrefix("tf_acc_test")
+	bucketName := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCur(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsCurReportDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAwsCurReportDefinitionConfig_athenaCreateNewReport(reportName, bucketName),
+				ExpectError: regexp.MustCompile(`report_versioning must be OVERWRITE_REPORT`),
+			},
+		},
+	})
+}
+
 func testAccCheckAwsCurReportDefinitionDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).costandusagereportconn
 
@@ -104,14 +164,14 @@ func testAccPreCheckAWSCur(t *testing.T) {
 }
 
 // note: cur report definitions are currently only supported in us-east-1
-func testAccAwsCurReportDefinitionConfig_basic(reportName string, bucketName string) string {
+func testAccAwsCurReportDefinitionConfigBase(bucketName string) string {
 	return fmt.Sprintf(`
 provider "aws" {
   region = "us-east-1"
 }
 
 resource "aws_s3_bucket" "test" {
-  bucket        = "%[2]s"
+  bucket        = "%[1]s"
   acl           = "private"
   force_destroy = true
 }
@@ -149,7 +209,11 @@ resource "aws_s3_bucket_policy" "test" {
 }
 POLICY
 }
+`, bucketName)
+}
 
+func testAccAwsCurReportDefinitionConfig_basic(reportName string, bucketName string) string {
+	return testAccAwsCurReportDefinitionConfigBase(bucketName) + fmt.Sprintf(`
 resource "aws_cur_report_definition" "test" {
   report_name                = "%[1]s"
   time_unit                  = "DAILY"
@@ -161,5 +225,40 @@ resource "aws_cur_report_definition" "test" {
   s3_region                  = aws_s3_bucket.test.region
   additional_artifacts       = ["REDSHIFT", "QUICKSIGHT"]
 }
-`, reportName, bucketName)
+`, reportName)
+}
+
+func testAccAwsCurReportDefinitionConfig_athena(reportName string, bucketName string) string {
+	return testAccAwsCurReportDefinitionConfigBase(bucketName) + fmt.Sprintf(`
+resource "aws_cur_report_definition" "test" {
+  report_name                = "%[1]s"
+  time_unit                  = "DAILY"
+  format                     = "Parquet"
+  compression                = "Parquet"
+  additional_schema_elements = ["RESOURCES"]
+  s3_bucket                  = aws_s3_bucket.test.id
+  s3_prefix                  = "athena"
+  s3_region                  = aws_s3_bucket.test.region
+  additional_artifacts       = ["ATHENA"]
+  refresh_closed_reports     = false
+  report_versioning          = "OVERWRITE_REPORT"
+}
+`, reportName)
+}
+
+func testAccAwsCurReportDefinitionConfig_athenaCreateNewReport(reportName string, bucketName string) string {
+	return testAccAwsCurReportDefinitionConfigBase(bucketName) + fmt.Sprintf(`
+resource "aws_cur_report_definition" "test" {
+  report_name                = "%[1]s"
+  time_unit                  = "DAILY"
+  format                     = "Parquet"
+  compression                = "Parquet"
+  additional_schema_elements = ["RESOURCES"]
+  s3_bucket                  = aws_s3_bucket.test.id
+  s3_prefix                  = "athena"
+  s3_region                  = aws_s3_bucket.test.region
+  additional_artifacts       = ["ATHENA"]
+  report_versioning          = "CREATE_NEW_REPORT"
+}
+`, reportName)
 }
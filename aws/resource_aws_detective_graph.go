@@ -0,0 +1,94 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/finder"
+)
+
+func resourceAwsDetectiveGraph() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDetectiveGraphCreate,
+		Read:   resourceAwsDetectiveGraphRead,
+		Delete: resourceAwsDetectiveGraphDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"graph_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDetectiveGraphCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).detectiveconn
+
+	log.Printf("[DEBUG] Creating Detective graph")
+	output, err := conn.CreateGraph(&detective.CreateGraphInput{})
+
+	if err != nil {
+		return fmt.Errorf("error creating Detective graph: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.GraphArn))
+
+	return resourceAwsDetectiveGraphRead(d, meta)
+}
+
+func resourceAwsDetectiveGraphRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).detectiveconn
+
+	graph, err := finder.GraphByARN(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading Detective graph (%s): %w", d.Id(), err)
+	}
+
+	if graph == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Detective graph (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Detective graph (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("created_time", aws.TimeValue(graph.CreatedTime).Format(time.RFC3339))
+	d.Set("graph_arn", graph.Arn)
+
+	return nil
+}
+
+func resourceAwsDetectiveGraphDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).detectiveconn
+
+	log.Printf("[DEBUG] Deleting Detective graph: %s", d.Id())
+	_, err := conn.DeleteGraph(&detective.DeleteGraphInput{
+		GraphArn: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, detective.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Detective graph (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/finder"
+)
+
+func testAccAwsDetectiveGraph_basic(t *testing.T) {
+	var graph detective.Graph
+	resourceName := "aws_detective_graph.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(detective.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDetectiveGraphDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDetectiveGraphConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDetectiveGraphExists(resourceName, &graph),
+					testAccMatchResourceAttrRegionalARN(resourceName, "graph_arn", "detective", regexp.MustCompile(`graph:.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "id", resourceName, "graph_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsDetectiveGraph_disappears(t *testing.T) {
+	var graph detective.Graph
+	resourceName := "aws_detective_graph.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(detective.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDetectiveGraphDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDetectiveGraphConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDetectiveGraphExists(resourceName, &graph),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsDetectiveGraph(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDetectiveGraphExists(n string, v *detective.Graph) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Detective graph ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).detectiveconn
+
+		graph, err := finder.GraphByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if graph == nil {
+			return fmt.Errorf("Detective graph (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *graph
+
+		return nil
+	}
+}
+
+func testAccCheckAwsDetectiveGraphDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).detectiveconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_detective_graph" {
+			continue
+		}
+
+		graph, err := finder.GraphByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if graph != nil {
+			return fmt.Errorf("Detective graph (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsDetectiveGraphConfig() string {
+	return `
+resource "aws_detective_graph" "test" {}
+`
+}
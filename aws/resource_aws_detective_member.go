@@ -0,0 +1,226 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/waiter"
+)
+
+func resourceAwsDetectiveMember() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDetectiveMemberCreate,
+		Read:   resourceAwsDetectiveMemberRead,
+		Delete: resourceAwsDetectiveMemberDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"disabled_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"graph_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"invited_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"message": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDetectiveMemberCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).detectiveconn
+
+	accountID := d.Get("account_id").(string)
+	graphARN := d.Get("graph_arn").(string)
+	input := &detective.CreateMembersInput{
+		Accounts: []*detective.Account{
+			{
+				AccountId:    aws.String(accountID),
+				EmailAddress: aws.String(d.Get("email").(string)),
+			},
+		},
+		GraphArn: aws.String(graphARN),
+	}
+
+	if v, ok := d.GetOk("message"); ok {
+		input.Message = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Detective member: %s", input)
+	output, err := conn.CreateMembers(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Detective member (%s) in graph (%s): %w", accountID, graphARN, err)
+	}
+
+	for _, unprocessed := range output.UnprocessedAccounts {
+		if unprocessed == nil {
+			continue
+		}
+
+		return fmt.Errorf("error creating Detective member (%s) in graph (%s): %s", aws.StringValue(unprocessed.AccountId), graphARN, aws.StringValue(unprocessed.Reason))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", graphARN, accountID))
+
+	member, err := waiter.MemberVerified(conn, graphARN, accountID)
+
+	// A member that failed verification can never be invited, so remove it
+	// rather than leave it tainted in state.
+	if member != nil && aws.StringValue(member.Status) == detective.MemberStatusVerificationFailed {
+		_, deleteErr := conn.DeleteMembers(&detective.DeleteMembersInput{
+			AccountIds: aws.StringSlice([]string{accountID}),
+			GraphArn:   aws.String(graphARN),
+		})
+
+		if deleteErr != nil {
+			return fmt.Errorf("error waiting for Detective member (%s) verification: %w; additionally, error deleting member: %s", d.Id(), err, deleteErr)
+		}
+
+		memberID := d.Id()
+		d.SetId("")
+
+		return fmt.Errorf("error waiting for Detective member (%s) verification: %w", memberID, err)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error waiting for Detective member (%s) verification: %w", d.Id(), err)
+	}
+
+	return resourceAwsDetectiveMemberRead(d, meta)
+}
+
+func resourceAwsDetectiveMemberRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).detectiveconn
+
+	graphARN, accountID, err := decodeDetectiveMemberID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	member, err := finder.MemberByGraphARNAndAccountID(conn, graphARN, accountID)
+
+	if isAWSErr(err, detective.ErrCodeResourceNotFoundException, "") && !d.IsNewResource() {
+		log.Printf("[WARN] Detective graph (%s) not found, removing member (%s) from state", graphARN, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Detective member (%s): %w", d.Id(), err)
+	}
+
+	if member == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Detective member (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Detective member (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("account_id", member.AccountId)
+	d.Set("disabled_reason", member.DisabledReason)
+	d.Set("email", member.EmailAddress)
+	d.Set("graph_arn", member.GraphArn)
+	d.Set("status", member.Status)
+
+	if v := member.InvitedTime; v != nil {
+		d.Set("invited_time", aws.TimeValue(v).Format(time.RFC3339))
+	}
+
+	if v := member.UpdatedTime; v != nil {
+		d.Set("updated_time", aws.TimeValue(v).Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceAwsDetectiveMemberDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).detectiveconn
+
+	graphARN, accountID, err := decodeDetectiveMemberID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Detective member: %s", d.Id())
+	output, err := conn.DeleteMembers(&detective.DeleteMembersInput{
+		AccountIds: aws.StringSlice([]string{accountID}),
+		GraphArn:   aws.String(graphARN),
+	})
+
+	if isAWSErr(err, detective.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Detective member (%s): %w", d.Id(), err)
+	}
+
+	for _, unprocessed := range output.UnprocessedAccounts {
+		if unprocessed == nil {
+			continue
+		}
+
+		return fmt.Errorf("error deleting Detective member (%s): %s", d.Id(), aws.StringValue(unprocessed.Reason))
+	}
+
+	return nil
+}
+
+// decodeDetectiveMemberID returns the graph ARN and member account ID from a
+// member ID of the form GRAPH-ARN/ACCOUNT-ID.
+func decodeDetectiveMemberID(id string) (graphARN, accountID string, err error) {
+	idx := strings.LastIndex(id, "/")
+
+	if idx <= 0 || idx == len(id)-1 {
+		return "", "", fmt.Errorf("Detective member ID must be of the form <Graph ARN>/<Member AWS Account ID>, was provided: %s", id)
+	}
+
+	return id[:idx], id[idx+1:], nil
+}
@@ -0,0 +1,214 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/finder"
+)
+
+func TestDecodeDetectiveMemberID(t *testing.T) {
+	testCases := []struct {
+		ID                string
+		ExpectedGraphARN  string
+		ExpectedAccountID string
+		ExpectError       bool
+	}{
+		{
+			ID:                "arn:aws:detective:us-west-2:123456789012:graph:b5f4a9c2e4d44cf8b8d1a7e1c6a0f2b3/111111111111",
+			ExpectedGraphARN:  "arn:aws:detective:us-west-2:123456789012:graph:b5f4a9c2e4d44cf8b8d1a7e1c6a0f2b3",
+			ExpectedAccountID: "111111111111",
+		},
+		{
+			ID:          "arn:aws:detective:us-west-2:123456789012:graph:b5f4a9c2e4d44cf8b8d1a7e1c6a0f2b3",
+			ExpectError: true,
+		},
+		{
+			ID:          "arn:aws:detective:us-west-2:123456789012:graph:b5f4a9c2e4d44cf8b8d1a7e1c6a0f2b3/",
+			ExpectError: true,
+		},
+		{
+			ID:          "/111111111111",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		graphARN, accountID, err := decodeDetectiveMemberID(tc.ID)
+
+		if tc.ExpectError {
+			if err == nil {
+				t.Errorf("expected error for %q", tc.ID)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("unexpected error for %q: %s", tc.ID, err)
+			continue
+		}
+
+		if graphARN != tc.ExpectedGraphARN {
+			t.Errorf("expected graph ARN %q for %q, got %q", tc.ExpectedGraphARN, tc.ID, graphARN)
+		}
+
+		if accountID != tc.ExpectedAccountID {
+			t.Errorf("expected account ID %q for %q, got %q", tc.ExpectedAccountID, tc.ID, accountID)
+		}
+	}
+}
+
+func testAccAwsDetectiveMember_basic(t *testing.T) {
+	var member detective.MemberDetail
+	resourceName := "aws_detective_member.test"
+	accountID := "111111111111"
+	email := "required@example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(detective.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDetectiveMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDetectiveMemberConfig(accountID, email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDetectiveMemberExists(resourceName, &member),
+					resource.TestCheckResourceAttr(resourceName, "account_id", accountID),
+					resource.TestCheckResourceAttr(resourceName, "email", email),
+					resource.TestCheckResourceAttrPair(resourceName, "graph_arn", "aws_detective_graph.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "status", detective.MemberStatusInvited),
+					resource.TestCheckResourceAttrSet(resourceName, "invited_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsDetectiveMember_message(t *testing.T) {
+	var member detective.MemberDetail
+	resourceName := "aws_detective_member.test"
+	accountID := "111111111111"
+	email := "required@example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(detective.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDetectiveMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDetectiveMemberConfigMessage(accountID, email, "This is a message of the invitation"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDetectiveMemberExists(resourceName, &member),
+					resource.TestCheckResourceAttr(resourceName, "message", "This is a message of the invitation"),
+					resource.TestCheckResourceAttr(resourceName, "status", detective.MemberStatusInvited),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"message"},
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDetectiveMemberExists(n string, v *detective.MemberDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Detective member ID is set")
+		}
+
+		graphARN, accountID, err := decodeDetectiveMemberID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).detectiveconn
+
+		member, err := finder.MemberByGraphARNAndAccountID(conn, graphARN, accountID)
+
+		if err != nil {
+			return err
+		}
+
+		if member == nil {
+			return fmt.Errorf("Detective member (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *member
+
+		return nil
+	}
+}
+
+func testAccCheckAwsDetectiveMemberDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).detectiveconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_detective_member" {
+			continue
+		}
+
+		graphARN, accountID, err := decodeDetectiveMemberID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		member, err := finder.MemberByGraphARNAndAccountID(conn, graphARN, accountID)
+
+		if isAWSErr(err, detective.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if member != nil {
+			return fmt.Errorf("Detective member (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsDetectiveMemberConfig(accountID, email string) string {
+	return fmt.Sprintf(`
+resource "aws_detective_graph" "test" {}
+
+resource "aws_detective_member" "test" {
+  account_id = %[1]q
+  email      = %[2]q
+  graph_arn  = aws_detective_graph.test.id
+}
+`, accountID, email)
+}
+
+func testAccAwsDetectiveMemberConfigMessage(accountID, email, message string) string {
+	return fmt.Sprintf(`
+resource "aws_detective_graph" "test" {}
+
+resource "aws_detective_member" "test" {
+  account_id = %[1]q
+  email      = %[2]q
+  graph_arn  = aws_detective_graph.test.id
+  message    = %[3]q
+}
+`, accountID, email, message)
+}
@@ -0,0 +1,30 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestAccAWSDetective_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"Graph": {
+			"basic":      testAccAwsDetectiveGraph_basic,
+			"disappears": testAccAwsDetectiveGraph_disappears,
+		},
+		"Member": {
+			"basic":   testAccAwsDetectiveMember_basic,
+			"message": testAccAwsDetectiveMember_message,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
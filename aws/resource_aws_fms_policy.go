@@ -0,0 +1,360 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsFmsPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsFmsPolicyCreate,
+		Read:   resourceAwsFmsPolicyRead,
+		Update: resourceAwsFmsPolicyUpdate,
+		Delete: resourceAwsFmsPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delete_all_policy_resources": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"exclude_map": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem:     resourceAwsFmsPolicyScopeMapSchema(),
+			},
+			"exclude_resource_tags": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"include_map": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem:     resourceAwsFmsPolicyScopeMapSchema(),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"policy_update_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"remediation_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"resource_tags": tagsSchema(),
+			"resource_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"resource_type", "resource_type_list"},
+			},
+			"resource_type_list": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Computed:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"resource_type", "resource_type_list"},
+			},
+			"security_service_policy_data": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"managed_service_data": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: suppressEquivalentJsonDiffs,
+							StateFunc: func(v interface{}) string {
+								json, _ := structure.NormalizeJsonString(v)
+								return json
+							},
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								fms.SecurityServiceTypeWaf,
+								fms.SecurityServiceTypeWafv2,
+								fms.SecurityServiceTypeShieldAdvanced,
+								fms.SecurityServiceTypeSecurityGroupsCommon,
+								fms.SecurityServiceTypeSecurityGroupsContentAudit,
+								fms.SecurityServiceTypeSecurityGroupsUsageAudit,
+							}, false),
+						},
+					},
+				},
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsFmsPolicyScopeMapSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"account": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"orgunit": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAwsFmsPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	input := &fms.PutPolicyInput{
+		Policy: resourceAwsFmsPolicyExpandPolicy(d),
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.TagList = keyvaluetags.New(v).IgnoreAws().FmsTags()
+	}
+
+	output, err := conn.PutPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating FMS Policy (%s): %s", d.Get("name").(string), err)
+	}
+
+	if output == nil || output.Policy == nil {
+		return fmt.Errorf("error creating FMS Policy (%s): empty result", d.Get("name").(string))
+	}
+
+	d.SetId(aws.StringValue(output.Policy.PolicyId))
+
+	return resourceAwsFmsPolicyRead(d, meta)
+}
+
+func resourceAwsFmsPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := conn.GetPolicy(&fms.GetPolicyInput{
+		PolicyId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] FMS Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error getting FMS Policy (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.Policy == nil {
+		return fmt.Errorf("error getting FMS Policy (%s): empty result", d.Id())
+	}
+
+	policy := output.Policy
+
+	d.Set("arn", output.PolicyArn)
+	d.Set("exclude_resource_tags", policy.ExcludeResourceTags)
+	d.Set("name", policy.PolicyName)
+	d.Set("policy_update_token", policy.PolicyUpdateToken)
+	d.Set("remediation_enabled", policy.RemediationEnabled)
+	d.Set("resource_type", policy.ResourceType)
+
+	if err := d.Set("exclude_map", resourceAwsFmsPolicyFlattenScopeMap(policy.ExcludeMap)); err != nil {
+		return fmt.Errorf("error setting exclude_map: %s", err)
+	}
+
+	if err := d.Set("include_map", resourceAwsFmsPolicyFlattenScopeMap(policy.IncludeMap)); err != nil {
+		return fmt.Errorf("error setting include_map: %s", err)
+	}
+
+	if err := d.Set("resource_tags", resourceAwsFmsPolicyFlattenResourceTags(policy.ResourceTags)); err != nil {
+		return fmt.Errorf("error setting resource_tags: %s", err)
+	}
+
+	if err := d.Set("resource_type_list", flattenStringSet(policy.ResourceTypeList)); err != nil {
+		return fmt.Errorf("error setting resource_type_list: %s", err)
+	}
+
+	securityServicePolicyData := []interface{}{
+		map[string]interface{}{
+			"managed_service_data": aws.StringValue(policy.SecurityServicePolicyData.ManagedServiceData),
+			"type":                 aws.StringValue(policy.SecurityServicePolicyData.Type),
+		},
+	}
+
+	if err := d.Set("security_service_policy_data", securityServicePolicyData); err != nil {
+		return fmt.Errorf("error setting security_service_policy_data: %s", err)
+	}
+
+	tags, err := keyvaluetags.FmsListTags(conn, aws.StringValue(output.PolicyArn))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for FMS Policy (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsFmsPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	policy := resourceAwsFmsPolicyExpandPolicy(d)
+	policy.PolicyId = aws.String(d.Id())
+	policy.PolicyUpdateToken = aws.String(d.Get("policy_update_token").(string))
+
+	_, err := conn.PutPolicy(&fms.PutPolicyInput{
+		Policy: policy,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error updating FMS Policy (%s): %s", d.Id(), err)
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.FmsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating FMS Policy (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsFmsPolicyRead(d, meta)
+}
+
+func resourceAwsFmsPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	_, err := conn.DeletePolicy(&fms.DeletePolicyInput{
+		PolicyId:                 aws.String(d.Id()),
+		DeleteAllPolicyResources: aws.Bool(d.Get("delete_all_policy_resources").(bool)),
+	})
+
+	if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting FMS Policy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsFmsPolicyExpandPolicy(d *schema.ResourceData) *fms.Policy {
+	policy := &fms.Policy{
+		ExcludeMap:          resourceAwsFmsPolicyExpandScopeMap(d.Get("exclude_map").([]interface{})),
+		ExcludeResourceTags: aws.Bool(d.Get("exclude_resource_tags").(bool)),
+		IncludeMap:          resourceAwsFmsPolicyExpandScopeMap(d.Get("include_map").([]interface{})),
+		PolicyName:          aws.String(d.Get("name").(string)),
+		RemediationEnabled:  aws.Bool(d.Get("remediation_enabled").(bool)),
+		ResourceTags:        resourceAwsFmsPolicyExpandResourceTags(d.Get("resource_tags").(map[string]interface{})),
+	}
+
+	// PutPolicy requires a resource type; when a list of resource types is
+	// given, the resource type must be "ResourceTypeList".
+	if v, ok := d.GetOk("resource_type_list"); ok && v.(*schema.Set).Len() > 0 {
+		policy.ResourceType = aws.String("ResourceTypeList")
+		policy.ResourceTypeList = expandStringSet(v.(*schema.Set))
+	} else {
+		policy.ResourceType = aws.String(d.Get("resource_type").(string))
+	}
+
+	securityServicePolicyData := d.Get("security_service_policy_data").([]interface{})[0].(map[string]interface{})
+
+	policy.SecurityServicePolicyData = &fms.SecurityServicePolicyData{
+		Type: aws.String(securityServicePolicyData["type"].(string)),
+	}
+
+	if v := securityServicePolicyData["managed_service_data"].(string); v != "" {
+		policy.SecurityServicePolicyData.ManagedServiceData = aws.String(v)
+	}
+
+	return policy
+}
+
+func resourceAwsFmsPolicyExpandScopeMap(l []interface{}) map[string][]*string {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	scopeMap := map[string][]*string{}
+
+	if v := m["account"].(*schema.Set); v.Len() > 0 {
+		scopeMap[fms.CustomerPolicyScopeIdTypeAccount] = expandStringSet(v)
+	}
+
+	if v := m["orgunit"].(*schema.Set); v.Len() > 0 {
+		scopeMap[fms.CustomerPolicyScopeIdTypeOrgUnit] = expandStringSet(v)
+	}
+
+	return scopeMap
+}
+
+func resourceAwsFmsPolicyFlattenScopeMap(scopeMap map[string][]*string) []interface{} {
+	if len(scopeMap) == 0 {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"account": flattenStringSet(scopeMap[fms.CustomerPolicyScopeIdTypeAccount]),
+		"orgunit": flattenStringSet(scopeMap[fms.CustomerPolicyScopeIdTypeOrgUnit]),
+	}
+
+	return []interface{}{m}
+}
+
+func resourceAwsFmsPolicyExpandResourceTags(m map[string]interface{}) []*fms.ResourceTag {
+	var resourceTags []*fms.ResourceTag
+
+	for k, v := range m {
+		resourceTags = append(resourceTags, &fms.ResourceTag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return resourceTags
+}
+
+func resourceAwsFmsPolicyFlattenResourceTags(resourceTags []*fms.ResourceTag) map[string]interface{} {
+	m := map[string]interface{}{}
+
+	for _, resourceTag := range resourceTags {
+		m[aws.StringValue(resourceTag.Key)] = aws.StringValue(resourceTag.Value)
+	}
+
+	return m
+}
@@ -0,0 +1,304 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAwsFmsPolicy_basic(t *testing.T) {
+	oldDefaultRegion := os.Getenv("AWS_DEFAULT_REGION")
+	os.Setenv("AWS_DEFAULT_REGION", "us-east-1")
+	defer os.Setenv("AWS_DEFAULT_REGION", oldDefaultRegion)
+
+	resourceName := "aws_fms_policy.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccOrganizationsEnabledPreCheck(t); testAccPreCheckFmsAdmin(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsFmsPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFmsPolicyConfigWafOrgUnit(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsFmsPolicyExists(resourceName),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "fms", regexp.MustCompile(`policy/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "exclude_resource_tags", "false"),
+					resource.TestCheckResourceAttr(resourceName, "include_map.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "include_map.0.orgunit.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "remediation_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.Environment", "test"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type_list.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.type", "WAF"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_all_policy_resources"},
+			},
+			{
+				Config: testAccFmsPolicyConfigWafOrgUnit(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsFmsPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "remediation_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsFmsPolicy_tags(t *testing.T) {
+	oldDefaultRegion := os.Getenv("AWS_DEFAULT_REGION")
+	os.Setenv("AWS_DEFAULT_REGION", "us-east-1")
+	defer os.Setenv("AWS_DEFAULT_REGION", oldDefaultRegion)
+
+	resourceName := "aws_fms_policy.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccOrganizationsEnabledPreCheck(t); testAccPreCheckFmsAdmin(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsFmsPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFmsPolicyConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsFmsPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_all_policy_resources"},
+			},
+			{
+				Config: testAccFmsPolicyConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsFmsPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccFmsPolicyConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsFmsPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPreCheckFmsAdmin(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).fmsconn
+
+	_, err := conn.GetAdminAccount(&fms.GetAdminAccountInput{})
+
+	if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+		t.Skip("this AWS account must be the Firewall Manager administrator account")
+	}
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccCheckAwsFmsPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No FMS Policy ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).fmsconn
+
+		_, err := conn.GetPolicy(&fms.GetPolicyInput{
+			PolicyId: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAwsFmsPolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).fmsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_fms_policy" {
+			continue
+		}
+
+		_, err := conn.GetPolicy(&fms.GetPolicyInput{
+			PolicyId: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("FMS Policy (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccFmsPolicyConfigWafOrgUnit(rName string, remediationEnabled bool) string {
+	return fmt.Sprintf(`
+data "aws_organizations_organization" "current" {}
+
+resource "aws_wafregional_rule_group" "test" {
+  metric_name = "MyTest"
+  name        = %[1]q
+}
+
+resource "aws_fms_policy" "test" {
+  exclude_resource_tags = false
+  name                  = %[1]q
+  remediation_enabled   = %[2]t
+  resource_type_list    = ["AWS::ElasticLoadBalancingV2::LoadBalancer", "AWS::ApiGateway::Stage"]
+
+  include_map {
+    orgunit = [data.aws_organizations_organization.current.roots[0].id]
+  }
+
+  resource_tags = {
+    Environment = "test"
+  }
+
+  security_service_policy_data {
+    type = "WAF"
+
+    managed_service_data = jsonencode({
+      type = "WAF"
+      ruleGroups = [{
+        id = aws_wafregional_rule_group.test.id
+        overrideAction = {
+          type = "COUNT"
+        }
+      }]
+      defaultAction = {
+        type = "BLOCK"
+      }
+      overrideCustomerWebACLAssociation = false
+    })
+  }
+}
+`, rName, remediationEnabled)
+}
+
+func testAccFmsPolicyConfigTagsBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_organizations_organization" "current" {}
+
+resource "aws_wafregional_rule_group" "test" {
+  metric_name = "MyTest"
+  name        = %[1]q
+}
+`, rName)
+}
+
+func testAccFmsPolicyConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccFmsPolicyConfigTagsBase(rName) + fmt.Sprintf(`
+resource "aws_fms_policy" "test" {
+  exclude_resource_tags = false
+  name                  = %[1]q
+  remediation_enabled   = false
+  resource_type         = "AWS::ElasticLoadBalancingV2::LoadBalancer"
+
+  include_map {
+    orgunit = [data.aws_organizations_organization.current.roots[0].id]
+  }
+
+  security_service_policy_data {
+    type = "WAF"
+
+    managed_service_data = jsonencode({
+      type = "WAF"
+      ruleGroups = [{
+        id = aws_wafregional_rule_group.test.id
+        overrideAction = {
+          type = "COUNT"
+        }
+      }]
+      defaultAction = {
+        type = "BLOCK"
+      }
+      overrideCustomerWebACLAssociation = false
+    })
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccFmsPolicyConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccFmsPolicyConfigTagsBase(rName) + fmt.Sprintf(`
+resource "aws_fms_policy" "test" {
+  exclude_resource_tags = false
+  name                  = %[1]q
+  remediation_enabled   = false
+  resource_type         = "AWS::ElasticLoadBalancingV2::LoadBalancer"
+
+  include_map {
+    orgunit = [data.aws_organizations_organization.current.roots[0].id]
+  }
+
+  security_service_policy_data {
+    type = "WAF"
+
+    managed_service_data = jsonencode({
+      type = "WAF"
+      ruleGroups = [{
+        id = aws_wafregional_rule_group.test.id
+        overrideAction = {
+          type = "COUNT"
+        }
+      }]
+      defaultAction = {
+        type = "BLOCK"
+      }
+      overrideCustomerWebACLAssociation = false
+    })
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -20,6 +20,7 @@ func resourceAwsLambdaLayerVersion() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsLambdaLayerVersionPublish,
 		Read:   resourceAwsLambdaLayerVersionRead,
+		Update: resourceAwsLambdaLayerVersionUpdate,
 		Delete: resourceAwsLambdaLayerVersionDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -97,6 +98,11 @@ func resourceAwsLambdaLayerVersion() *schema.Resource {
 				ForceNew: true,
 				Computed: true,
 			},
+			"skip_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"source_code_size": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -224,9 +230,20 @@ func resourceAwsLambdaLayerVersionRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+func resourceAwsLambdaLayerVersionUpdate(d *schema.ResourceData, meta interface{}) error {
+	// skip_destroy is the only argument that can be updated in-place and it
+	// is only used during deletion.
+	return resourceAwsLambdaLayerVersionRead(d, meta)
+}
+
 func resourceAwsLambdaLayerVersionDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).lambdaconn
 
+	if d.Get("skip_destroy").(bool) {
+		log.Printf("[DEBUG] Retaining Lambda Layer Version (%s)", d.Id())
+		return nil
+	}
+
 	version, err := strconv.ParseInt(d.Get("version").(string), 10, 64)
 	if err != nil {
 		return fmt.Errorf("Error parsing lambda layer version: %s", err)
@@ -90,7 +90,7 @@ func TestAccAWSLambdaLayerVersion_basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"filename"},
+				ImportStateVerifyIgnore: []string{"filename", "skip_destroy"},
 			},
 		},
 	})
@@ -114,7 +114,7 @@ func TestAccAWSLambdaLayerVersion_update(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"filename", "source_code_hash"},
+				ImportStateVerifyIgnore: []string{"filename", "skip_destroy", "source_code_hash"},
 			},
 
 			{
@@ -145,7 +145,7 @@ func TestAccAWSLambdaLayerVersion_s3(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"s3_bucket", "s3_key"},
+				ImportStateVerifyIgnore: []string{"s3_bucket", "s3_key", "skip_destroy"},
 			},
 		},
 	})
@@ -173,7 +173,7 @@ func TestAccAWSLambdaLayerVersion_compatibleRuntimes(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"filename"},
+				ImportStateVerifyIgnore: []string{"filename", "skip_destroy"},
 			},
 		},
 	})
@@ -202,7 +202,7 @@ func TestAccAWSLambdaLayerVersion_description(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"filename"},
+				ImportStateVerifyIgnore: []string{"filename", "skip_destroy"},
 			},
 		},
 	})
@@ -231,7 +231,29 @@ func TestAccAWSLambdaLayerVersion_licenseInfo(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"filename"},
+				ImportStateVerifyIgnore: []string{"filename", "skip_destroy"},
+			},
+		},
+	})
+}
+
+func TestAccAWSLambdaLayerVersion_skipDestroy(t *testing.T) {
+	resourceName := "aws_lambda_layer_version.lambda_layer_test"
+	rString := acctest.RandString(8)
+	layerName := fmt.Sprintf("tf_acc_lambda_layer_skip_destroy_%s", rString)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		// The layer version is intentionally retained on destroy.
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLambdaLayerVersionSkipDestroy(layerName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLambdaLayerVersionExists(resourceName, layerName),
+					resource.TestCheckResourceAttr(resourceName, "skip_destroy", "true"),
+				),
 			},
 		},
 	})
@@ -371,3 +393,13 @@ resource "aws_lambda_layer_version" "lambda_layer_test" {
 }
 `, layerName, licenseInfo)
 }
+
+func testAccAWSLambdaLayerVersionSkipDestroy(layerName string) string {
+	return fmt.Sprintf(`
+resource "aws_lambda_layer_version" "lambda_layer_test" {
+  filename     = "test-fixtures/lambdatest.zip"
+  layer_name   = %[1]q
+  skip_destroy = true
+}
+`, layerName)
+}
@@ -0,0 +1,134 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/polly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsPollyLexicon() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsPollyLexiconPut,
+		Read:   resourceAwsPollyLexiconRead,
+		Update: resourceAwsPollyLexiconPut,
+		Delete: resourceAwsPollyLexiconDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alphabet": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validatePollyLexiconContent,
+			},
+			"language_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"lexemes_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[0-9A-Za-z]{1,20}$`),
+					"must be 1-20 alphanumeric characters",
+				),
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsPollyLexiconPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pollyconn
+
+	name := d.Get("name").(string)
+	input := &polly.PutLexiconInput{
+		Content: aws.String(d.Get("content").(string)),
+		Name:    aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Putting Polly lexicon: %s", name)
+	if _, err := conn.PutLexicon(input); err != nil {
+		return fmt.Errorf("error putting Polly lexicon (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsPollyLexiconRead(d, meta)
+}
+
+func resourceAwsPollyLexiconRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pollyconn
+
+	output, err := conn.GetLexicon(&polly.GetLexiconInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, polly.ErrCodeLexiconNotFoundException, "") && !d.IsNewResource() {
+		log.Printf("[WARN] Polly lexicon (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Polly lexicon (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || output.Lexicon == nil || output.LexiconAttributes == nil {
+		return fmt.Errorf("error reading Polly lexicon (%s): empty response", d.Id())
+	}
+
+	d.Set("content", output.Lexicon.Content)
+	d.Set("name", output.Lexicon.Name)
+
+	attributes := output.LexiconAttributes
+	d.Set("alphabet", attributes.Alphabet)
+	d.Set("arn", attributes.LexiconArn)
+	d.Set("language_code", attributes.LanguageCode)
+	d.Set("lexemes_count", attributes.LexemesCount)
+	d.Set("size", attributes.Size)
+
+	return nil
+}
+
+func resourceAwsPollyLexiconDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pollyconn
+
+	log.Printf("[DEBUG] Deleting Polly lexicon: %s", d.Id())
+	_, err := conn.DeleteLexicon(&polly.DeleteLexiconInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, polly.ErrCodeLexiconNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Polly lexicon (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/polly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSPollyLexicon_basic(t *testing.T) {
+	var lexicon polly.GetLexiconOutput
+	resourceName := "aws_polly_lexicon.test"
+	rName := fmt.Sprintf("tf%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(polly.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPollyLexiconDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPollyLexiconConfig(rName, "World Wide Web Consortium"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPollyLexiconExists(resourceName, &lexicon),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "alphabet", "ipa"),
+					resource.TestCheckResourceAttr(resourceName, "language_code", "en-US"),
+					resource.TestCheckResourceAttr(resourceName, "lexemes_count", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "size"),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "polly", fmt.Sprintf("lexicon/%s", rName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSPollyLexicon_content(t *testing.T) {
+	var lexicon polly.GetLexiconOutput
+	resourceName := "aws_polly_lexicon.test"
+	rName := fmt.Sprintf("tf%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(polly.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPollyLexiconDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPollyLexiconConfig(rName, "World Wide Web Consortium"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPollyLexiconExists(resourceName, &lexicon),
+					resource.TestMatchResourceAttr(resourceName, "content", regexp.MustCompile(`World Wide Web Consortium`)),
+				),
+			},
+			{
+				Config: testAccAWSPollyLexiconConfig(rName, "World Wide Web"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPollyLexiconExists(resourceName, &lexicon),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestMatchResourceAttr(resourceName, "content", regexp.MustCompile(`<alias>World Wide Web</alias>`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSPollyLexicon_disappears(t *testing.T) {
+	var lexicon polly.GetLexiconOutput
+	resourceName := "aws_polly_lexicon.test"
+	rName := fmt.Sprintf("tf%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(polly.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPollyLexiconDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPollyLexiconConfig(rName, "World Wide Web Consortium"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPollyLexiconExists(resourceName, &lexicon),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsPollyLexicon(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSPollyLexiconExists(n string, v *polly.GetLexiconOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Polly lexicon ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).pollyconn
+
+		output, err := conn.GetLexicon(&polly.GetLexiconInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSPollyLexiconDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).pollyconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_polly_lexicon" {
+			continue
+		}
+
+		_, err := conn.GetLexicon(&polly.GetLexiconInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, polly.ErrCodeLexiconNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Polly lexicon (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSPollyLexiconConfig(rName, alias string) string {
+	return fmt.Sprintf(`
+resource "aws_polly_lexicon" "test" {
+  name = %[1]q
+
+  content = <<EOF
+<?xml version="1.0" encoding="UTF-8"?>
+<lexicon version="1.0"
+      xmlns="http://www.w3.org/2005/01/pronunciation-lexicon"
+      alphabet="ipa"
+      xml:lang="en-US">
+  <lexeme>
+    <grapheme>W3C</grapheme>
+    <alias>%[2]s</alias>
+  </lexeme>
+</lexicon>
+EOF
+}
+`, rName, alias)
+}
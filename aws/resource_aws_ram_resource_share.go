@@ -45,6 +45,16 @@ func resourceAwsRamResourceShare() *schema.Resource {
 				Default:  false,
 			},
 
+			"permission_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+			},
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -58,6 +68,10 @@ func resourceAwsRamResourceShareCreate(d *schema.ResourceData, meta interface{})
 		AllowExternalPrincipals: aws.Bool(d.Get("allow_external_principals").(bool)),
 	}
 
+	if v, ok := d.GetOk("permission_arns"); ok && v.(*schema.Set).Len() > 0 {
+		request.PermissionArns = expandStringSet(v.(*schema.Set))
+	}
+
 	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
 		request.Tags = keyvaluetags.New(v).IgnoreAws().RamTags()
 	}
@@ -122,6 +136,15 @@ func resourceAwsRamResourceShareRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("name", resourceShare.Name)
 	d.Set("allow_external_principals", resourceShare.AllowExternalPrincipals)
 
+	permissionArns, err := resourceAwsRamResourceSharePermissionArns(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error listing RAM resource share (%s) permissions: %s", d.Id(), err)
+	}
+
+	if err := d.Set("permission_arns", permissionArns); err != nil {
+		return fmt.Errorf("error setting permission_arns: %s", err)
+	}
+
 	if err := d.Set("tags", keyvaluetags.RamKeyValueTags(resourceShare.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
@@ -151,6 +174,63 @@ func resourceAwsRamResourceShareUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if d.HasChange("permission_arns") {
+		o, n := d.GetChange("permission_arns")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		// A resource share has at most one permission per resource type, so an
+		// added permission for the same resource type as a removed one must
+		// replace it rather than be associated alongside it.
+		removed := map[string]*string{}
+		for _, permissionArn := range expandStringSet(os.Difference(ns)) {
+			resourceType, err := resourceAwsRamPermissionResourceType(conn, permissionArn)
+
+			if err != nil {
+				return fmt.Errorf("error reading RAM permission (%s): %s", aws.StringValue(permissionArn), err)
+			}
+
+			removed[resourceType] = permissionArn
+		}
+
+		for _, permissionArn := range expandStringSet(ns.Difference(os)) {
+			resourceType, err := resourceAwsRamPermissionResourceType(conn, permissionArn)
+
+			if err != nil {
+				return fmt.Errorf("error reading RAM permission (%s): %s", aws.StringValue(permissionArn), err)
+			}
+
+			input := &ram.AssociateResourceSharePermissionInput{
+				PermissionArn:    permissionArn,
+				ResourceShareArn: aws.String(d.Id()),
+			}
+
+			if _, ok := removed[resourceType]; ok {
+				input.Replace = aws.Bool(true)
+				delete(removed, resourceType)
+			}
+
+			log.Printf("[DEBUG] Associating RAM resource share (%s) permission: %s", d.Id(), aws.StringValue(permissionArn))
+			_, err = conn.AssociateResourceSharePermission(input)
+
+			if err != nil {
+				return fmt.Errorf("error associating RAM resource share (%s) permission (%s): %s", d.Id(), aws.StringValue(permissionArn), err)
+			}
+		}
+
+		for _, permissionArn := range removed {
+			log.Printf("[DEBUG] Disassociating RAM resource share (%s) permission: %s", d.Id(), aws.StringValue(permissionArn))
+			_, err := conn.DisassociateResourceSharePermission(&ram.DisassociateResourceSharePermissionInput{
+				PermissionArn:    permissionArn,
+				ResourceShareArn: aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return fmt.Errorf("error disassociating RAM resource share (%s) permission (%s): %s", d.Id(), aws.StringValue(permissionArn), err)
+			}
+		}
+	}
+
 	if d.HasChange("tags") {
 		o, n := d.GetChange("tags")
 
@@ -215,3 +295,52 @@ func resourceAwsRamResourceShareStateRefreshFunc(conn *ram.RAM, resourceShareArn
 		return resourceShare, aws.StringValue(resourceShare.Status), nil
 	}
 }
+
+func resourceAwsRamResourceSharePermissionArns(conn *ram.RAM, resourceShareArn string) ([]*string, error) {
+	var permissionArns []*string
+
+	input := &ram.ListResourceSharePermissionsInput{
+		ResourceShareArn: aws.String(resourceShareArn),
+	}
+
+	for {
+		output, err := conn.ListResourceSharePermissions(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, permission := range output.Permissions {
+			if permission == nil {
+				continue
+			}
+
+			permissionArns = append(permissionArns, permission.Arn)
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return permissionArns, nil
+}
+
+// resourceAwsRamPermissionResourceType returns the resource type a RAM permission applies to.
+func resourceAwsRamPermissionResourceType(conn *ram.RAM, permissionArn *string) (string, error) {
+	output, err := conn.GetPermission(&ram.GetPermissionInput{
+		PermissionArn: permissionArn,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.Permission == nil {
+		return "", fmt.Errorf("empty response")
+	}
+
+	return aws.StringValue(output.Permission.ResourceType), nil
+}
@@ -75,6 +75,39 @@ func TestAccAwsRamResourceShare_AllowExternalPrincipals(t *testing.T) {
 	})
 }
 
+func TestAccAwsRamResourceShare_PermissionArns(t *testing.T) {
+	var resourceShare ram.ResourceShare
+	resourceName := "aws_ram_resource_share.example"
+	shareName := fmt.Sprintf("tf-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRamResourceShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRamResourceShareConfigPermissionArns(shareName, "AWSRAMDefaultPermissionSubnet"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRamResourceShareExists(resourceName, &resourceShare),
+					resource.TestCheckResourceAttr(resourceName, "permission_arns.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsRamResourceShareConfigPermissionArns(shareName, "AWSRAMDefaultPermissionTransitGateway"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRamResourceShareExists(resourceName, &resourceShare),
+					resource.TestCheckResourceAttr(resourceName, "permission_arns.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAwsRamResourceShare_Name(t *testing.T) {
 	var resourceShare1, resourceShare2 ram.ResourceShare
 	resourceName := "aws_ram_resource_share.example"
@@ -228,6 +261,17 @@ resource "aws_ram_resource_share" "example" {
 `, allowExternalPrincipals, shareName)
 }
 
+func testAccAwsRamResourceShareConfigPermissionArns(shareName, permissionName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_ram_resource_share" "example" {
+  name            = %[1]q
+  permission_arns = ["arn:${data.aws_partition.current.partition}:ram::aws:permission/%[2]s"]
+}
+`, shareName, permissionName)
+}
+
 func testAccAwsRamResourceShareConfigName(shareName string) string {
 	return fmt.Sprintf(`
 resource "aws_ram_resource_share" "example" {
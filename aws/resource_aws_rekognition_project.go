@@ -0,0 +1,145 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rekognition/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rekognition/waiter"
+)
+
+func resourceAwsRekognitionProject() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRekognitionProjectCreate,
+		Read:   resourceAwsRekognitionProjectRead,
+		Delete: resourceAwsRekognitionProjectDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.\-]+$`), "must contain only alphanumeric characters, underscores, hyphens and periods"),
+				),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsRekognitionProjectCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rekognitionconn
+
+	name := d.Get("name").(string)
+	input := &rekognition.CreateProjectInput{
+		ProjectName: aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Creating Rekognition project: %s", input)
+	output, err := conn.CreateProject(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Rekognition project (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ProjectArn))
+
+	if _, err := waiter.ProjectCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Rekognition project (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsRekognitionProjectRead(d, meta)
+}
+
+func resourceAwsRekognitionProjectRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rekognitionconn
+
+	project, err := finder.ProjectByARN(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading Rekognition project (%s): %w", d.Id(), err)
+	}
+
+	if project == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Rekognition project (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Rekognition project (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	name, err := rekognitionProjectNameFromArn(aws.StringValue(project.ProjectArn))
+
+	if err != nil {
+		return err
+	}
+
+	d.Set("arn", project.ProjectArn)
+	d.Set("name", name)
+	d.Set("status", project.Status)
+
+	return nil
+}
+
+func resourceAwsRekognitionProjectDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rekognitionconn
+
+	log.Printf("[DEBUG] Deleting Rekognition project: %s", d.Id())
+	_, err := conn.DeleteProject(&rekognition.DeleteProjectInput{
+		ProjectArn: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, rekognition.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Rekognition project (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.ProjectDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Rekognition project (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// rekognitionProjectNameFromArn returns the project name from a project ARN of
+// the form arn:PARTITION:rekognition:REGION:ACCOUNT:project/NAME/TIMESTAMP.
+func rekognitionProjectNameFromArn(v string) (string, error) {
+	parsedArn, err := arn.Parse(v)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing Rekognition project ARN (%s): %w", v, err)
+	}
+
+	parts := strings.Split(parsedArn.Resource, "/")
+
+	if len(parts) != 3 || parts[0] != "project" || parts[1] == "" {
+		return "", fmt.Errorf("unexpected format for Rekognition project ARN (%s), expected project/NAME/TIMESTAMP resource", v)
+	}
+
+	return parts[1], nil
+}
@@ -0,0 +1,171 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rekognition/finder"
+)
+
+func TestRekognitionProjectNameFromArn(t *testing.T) {
+	testCases := []struct {
+		Arn          string
+		ExpectedName string
+		ExpectError  bool
+	}{
+		{
+			Arn:          "arn:aws:rekognition:us-west-2:123456789012:project/my-project/1594672234567",
+			ExpectedName: "my-project",
+		},
+		{
+			Arn:          "arn:aws-us-gov:rekognition:us-gov-west-1:123456789012:project/my.project_1/1594672234567",
+			ExpectedName: "my.project_1",
+		},
+		{
+			Arn:         "my-project",
+			ExpectError: true,
+		},
+		{
+			Arn:         "arn:aws:rekognition:us-west-2:123456789012:collection/my-collection",
+			ExpectError: true,
+		},
+		{
+			Arn:         "arn:aws:rekognition:us-west-2:123456789012:project/my-project/1594672234567/version/v1/1594672234568",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		name, err := rekognitionProjectNameFromArn(tc.Arn)
+
+		if tc.ExpectError {
+			if err == nil {
+				t.Errorf("expected error for %q, got name %q", tc.Arn, name)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("unexpected error for %q: %s", tc.Arn, err)
+			continue
+		}
+
+		if name != tc.ExpectedName {
+			t.Errorf("expected name %q for %q, got %q", tc.ExpectedName, tc.Arn, name)
+		}
+	}
+}
+
+func TestAccAWSRekognitionProject_basic(t *testing.T) {
+	var project rekognition.ProjectDescription
+	resourceName := "aws_rekognition_project.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(rekognition.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRekognitionProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRekognitionProjectConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRekognitionProjectExists(resourceName, &project),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", rekognition.ProjectStatusCreated),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "rekognition", regexp.MustCompile(fmt.Sprintf(`project/%s/[0-9]+$`, rName))),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRekognitionProject_disappears(t *testing.T) {
+	var project rekognition.ProjectDescription
+	resourceName := "aws_rekognition_project.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(rekognition.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRekognitionProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRekognitionProjectConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRekognitionProjectExists(resourceName, &project),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsRekognitionProject(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSRekognitionProjectExists(n string, v *rekognition.ProjectDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Rekognition project ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).rekognitionconn
+
+		project, err := finder.ProjectByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if project == nil {
+			return fmt.Errorf("Rekognition project (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *project
+
+		return nil
+	}
+}
+
+func testAccCheckAWSRekognitionProjectDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).rekognitionconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_rekognition_project" {
+			continue
+		}
+
+		project, err := finder.ProjectByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if project != nil {
+			return fmt.Errorf("Rekognition project (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSRekognitionProjectConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_rekognition_project" "test" {
+  name = %[1]q
+}
+`, rName)
+}
@@ -0,0 +1,379 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	iamwaiter "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/waiter"
+)
+
+func resourceAwsRekognitionStreamProcessor() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRekognitionStreamProcessorCreate,
+		Read:   resourceAwsRekognitionStreamProcessorRead,
+		Delete: resourceAwsRekognitionStreamProcessorDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"input": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kinesis_video_stream": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validateArn,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.\-]+$`), "must contain only alphanumeric characters, underscores, hyphens and periods"),
+				),
+			},
+			"output": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kinesis_data_stream": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validateArn,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"settings": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"face_search": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"collection_id": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(1, 255),
+											validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.\-]+$`), "must contain only alphanumeric characters, underscores, hyphens and periods"),
+										),
+									},
+									"face_match_threshold": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.FloatBetween(0, 100),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsRekognitionStreamProcessorCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rekognitionconn
+
+	name := d.Get("name").(string)
+	input := &rekognition.CreateStreamProcessorInput{
+		Input:    expandRekognitionStreamProcessorInput(d.Get("input").([]interface{})),
+		Name:     aws.String(name),
+		Output:   expandRekognitionStreamProcessorOutput(d.Get("output").([]interface{})),
+		RoleArn:  aws.String(d.Get("role_arn").(string)),
+		Settings: expandRekognitionStreamProcessorSettings(d.Get("settings").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Creating Rekognition stream processor: %s", input)
+	// IAM propagation of a freshly created role can delay its use by Rekognition.
+	err := resource.Retry(iamwaiter.PropagationTimeout, func() *resource.RetryError {
+		_, err := conn.CreateStreamProcessor(input)
+
+		if isAWSErr(err, rekognition.ErrCodeAccessDeniedException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.CreateStreamProcessor(input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error creating Rekognition stream processor (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsRekognitionStreamProcessorRead(d, meta)
+}
+
+func resourceAwsRekognitionStreamProcessorRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rekognitionconn
+
+	output, err := conn.DescribeStreamProcessor(&rekognition.DescribeStreamProcessorInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, rekognition.ErrCodeResourceNotFoundException, "") && !d.IsNewResource() {
+		log.Printf("[WARN] Rekognition stream processor (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Rekognition stream processor (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.StreamProcessorArn)
+	d.Set("name", output.Name)
+	d.Set("role_arn", output.RoleArn)
+	d.Set("status", output.Status)
+
+	if err := d.Set("input", flattenRekognitionStreamProcessorInput(output.Input)); err != nil {
+		return fmt.Errorf("error setting input: %w", err)
+	}
+
+	if err := d.Set("output", flattenRekognitionStreamProcessorOutput(output.Output)); err != nil {
+		return fmt.Errorf("error setting output: %w", err)
+	}
+
+	if err := d.Set("settings", flattenRekognitionStreamProcessorSettings(output.Settings)); err != nil {
+		return fmt.Errorf("error setting settings: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsRekognitionStreamProcessorDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rekognitionconn
+
+	input := &rekognition.DeleteStreamProcessorInput{
+		Name: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Rekognition stream processor: %s", d.Id())
+	// A stream processor started outside of Terraform must be stopped before
+	// it can be deleted.
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, err := conn.DeleteStreamProcessor(input)
+
+		if isAWSErr(err, rekognition.ErrCodeResourceInUseException, "") {
+			_, stopErr := conn.StopStreamProcessor(&rekognition.StopStreamProcessorInput{
+				Name: aws.String(d.Id()),
+			})
+
+			if stopErr != nil && !isAWSErr(stopErr, rekognition.ErrCodeResourceInUseException, "") {
+				return resource.NonRetryableError(fmt.Errorf("error stopping Rekognition stream processor (%s): %w", d.Id(), stopErr))
+			}
+
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.DeleteStreamProcessor(input)
+	}
+
+	if isAWSErr(err, rekognition.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Rekognition stream processor (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandRekognitionStreamProcessorInput(l []interface{}) *rekognition.StreamProcessorInput {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	input := &rekognition.StreamProcessorInput{}
+
+	if v, ok := m["kinesis_video_stream"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		input.KinesisVideoStream = &rekognition.KinesisVideoStream{
+			Arn: aws.String(v[0].(map[string]interface{})["arn"].(string)),
+		}
+	}
+
+	return input
+}
+
+func flattenRekognitionStreamProcessorInput(input *rekognition.StreamProcessorInput) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if v := input.KinesisVideoStream; v != nil {
+		m["kinesis_video_stream"] = []interface{}{
+			map[string]interface{}{
+				"arn": aws.StringValue(v.Arn),
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
+
+func expandRekognitionStreamProcessorOutput(l []interface{}) *rekognition.StreamProcessorOutput {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	output := &rekognition.StreamProcessorOutput{}
+
+	if v, ok := m["kinesis_data_stream"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		output.KinesisDataStream = &rekognition.KinesisDataStream{
+			Arn: aws.String(v[0].(map[string]interface{})["arn"].(string)),
+		}
+	}
+
+	return output
+}
+
+func flattenRekognitionStreamProcessorOutput(output *rekognition.StreamProcessorOutput) []interface{} {
+	if output == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if v := output.KinesisDataStream; v != nil {
+		m["kinesis_data_stream"] = []interface{}{
+			map[string]interface{}{
+				"arn": aws.StringValue(v.Arn),
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
+
+func expandRekognitionStreamProcessorSettings(l []interface{}) *rekognition.StreamProcessorSettings {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	settings := &rekognition.StreamProcessorSettings{}
+
+	if v, ok := m["face_search"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		faceSearch := &rekognition.FaceSearchSettings{
+			CollectionId: aws.String(tfMap["collection_id"].(string)),
+		}
+
+		if v, ok := tfMap["face_match_threshold"].(float64); ok && v != 0 {
+			faceSearch.FaceMatchThreshold = aws.Float64(v)
+		}
+
+		settings.FaceSearch = faceSearch
+	}
+
+	return settings
+}
+
+func flattenRekognitionStreamProcessorSettings(settings *rekognition.StreamProcessorSettings) []interface{} {
+	if settings == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if v := settings.FaceSearch; v != nil {
+		m["face_search"] = []interface{}{
+			map[string]interface{}{
+				"collection_id":        aws.StringValue(v.CollectionId),
+				"face_match_threshold": aws.Float64Value(v.FaceMatchThreshold),
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
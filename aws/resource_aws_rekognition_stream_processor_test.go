@@ -0,0 +1,318 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestExpandRekognitionStreamProcessorSettings(t *testing.T) {
+	testCases := []struct {
+		Input    []interface{}
+		Expected *rekognition.StreamProcessorSettings
+	}{
+		{
+			Input:    []interface{}{},
+			Expected: nil,
+		},
+		{
+			Input: []interface{}{
+				map[string]interface{}{
+					"face_search": []interface{}{
+						map[string]interface{}{
+							"collection_id":        "test",
+							"face_match_threshold": 0.0,
+						},
+					},
+				},
+			},
+			Expected: &rekognition.StreamProcessorSettings{
+				FaceSearch: &rekognition.FaceSearchSettings{
+					CollectionId: aws.String("test"),
+				},
+			},
+		},
+		{
+			Input: []interface{}{
+				map[string]interface{}{
+					"face_search": []interface{}{
+						map[string]interface{}{
+							"collection_id":        "test",
+							"face_match_threshold": 85.5,
+						},
+					},
+				},
+			},
+			Expected: &rekognition.StreamProcessorSettings{
+				FaceSearch: &rekognition.FaceSearchSettings{
+					CollectionId:       aws.String("test"),
+					FaceMatchThreshold: aws.Float64(85.5),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		actual := expandRekognitionStreamProcessorSettings(tc.Input)
+
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Errorf("expected %s, got %s", tc.Expected, actual)
+		}
+	}
+}
+
+func TestFlattenRekognitionStreamProcessorSettings(t *testing.T) {
+	testCases := []struct {
+		Input    *rekognition.StreamProcessorSettings
+		Expected []interface{}
+	}{
+		{
+			Input:    nil,
+			Expected: []interface{}{},
+		},
+		{
+			Input: &rekognition.StreamProcessorSettings{
+				FaceSearch: &rekognition.FaceSearchSettings{
+					CollectionId:       aws.String("test"),
+					FaceMatchThreshold: aws.Float64(80),
+				},
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"face_search": []interface{}{
+						map[string]interface{}{
+							"collection_id":        "test",
+							"face_match_threshold": 80.0,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		actual := flattenRekognitionStreamProcessorSettings(tc.Input)
+
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Errorf("expected %#v, got %#v", tc.Expected, actual)
+		}
+	}
+}
+
+func TestAccAWSRekognitionStreamProcessor_basic(t *testing.T) {
+	var streamProcessor rekognition.DescribeStreamProcessorOutput
+	resourceName := "aws_rekognition_stream_processor.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(rekognition.EndpointsID, t)
+			testAccPreCheckAWSRekognitionCollection(t, rName)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRekognitionStreamProcessorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRekognitionStreamProcessorConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRekognitionStreamProcessorExists(resourceName, &streamProcessor),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", rekognition.StreamProcessorStatusStopped),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "rekognition", fmt.Sprintf("streamprocessor/%s", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "input.0.kinesis_video_stream.0.arn", "aws_kinesis_video_stream.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "output.0.kinesis_data_stream.0.arn", "aws_kinesis_stream.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.face_search.0.collection_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.face_search.0.face_match_threshold", "85"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRekognitionStreamProcessor_disappears(t *testing.T) {
+	var streamProcessor rekognition.DescribeStreamProcessorOutput
+	resourceName := "aws_rekognition_stream_processor.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(rekognition.EndpointsID, t)
+			testAccPreCheckAWSRekognitionCollection(t, rName)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRekognitionStreamProcessorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRekognitionStreamProcessorConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRekognitionStreamProcessorExists(resourceName, &streamProcessor),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsRekognitionStreamProcessor(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testAccPreCheckAWSRekognitionCollection creates the face collection a
+// stream processor searches, as the provider has no resource for it, and
+// removes it once the test has finished.
+func testAccPreCheckAWSRekognitionCollection(t *testing.T, collectionID string) {
+	conn := testAccProvider.Meta().(*AWSClient).rekognitionconn
+
+	_, err := conn.CreateCollection(&rekognition.CreateCollectionInput{
+		CollectionId: aws.String(collectionID),
+	})
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("error creating Rekognition collection (%s): %s", collectionID, err)
+	}
+
+	t.Cleanup(func() {
+		_, err := conn.DeleteCollection(&rekognition.DeleteCollectionInput{
+			CollectionId: aws.String(collectionID),
+		})
+
+		if err != nil && !isAWSErr(err, rekognition.ErrCodeResourceNotFoundException, "") {
+			t.Errorf("error deleting Rekognition collection (%s): %s", collectionID, err)
+		}
+	})
+}
+
+func testAccCheckAWSRekognitionStreamProcessorExists(n string, v *rekognition.DescribeStreamProcessorOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Rekognition stream processor ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).rekognitionconn
+
+		output, err := conn.DescribeStreamProcessor(&rekognition.DescribeStreamProcessorInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSRekognitionStreamProcessorDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).rekognitionconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_rekognition_stream_processor" {
+			continue
+		}
+
+		_, err := conn.DescribeStreamProcessor(&rekognition.DescribeStreamProcessorInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, rekognition.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Rekognition stream processor (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSRekognitionStreamProcessorConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "rekognition.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AmazonRekognitionServiceRole"
+}
+
+resource "aws_kinesis_video_stream" "test" {
+  name = %[1]q
+}
+
+# The AmazonRekognitionServiceRole policy only grants access to Kinesis data
+# streams whose names begin with AmazonRekognition.
+resource "aws_kinesis_stream" "test" {
+  name        = "AmazonRekognition-%[1]s"
+  shard_count = 1
+}
+
+resource "aws_rekognition_stream_processor" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  input {
+    kinesis_video_stream {
+      arn = aws_kinesis_video_stream.test.arn
+    }
+  }
+
+  output {
+    kinesis_data_stream {
+      arn = aws_kinesis_stream.test.arn
+    }
+  }
+
+  settings {
+    face_search {
+      collection_id        = %[1]q
+      face_match_threshold = 85
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName)
+}
@@ -0,0 +1,90 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAwsServiceQuotasTemplateAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceQuotasTemplateAssociationCreate,
+		Read:   resourceAwsServiceQuotasTemplateAssociationRead,
+		Delete: resourceAwsServiceQuotasTemplateAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceQuotasTemplateAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicequotasconn
+
+	_, err := conn.AssociateServiceQuotaTemplate(&servicequotas.AssociateServiceQuotaTemplateInput{})
+
+	if err != nil {
+		return fmt.Errorf("error associating Service Quotas Template: %s", err)
+	}
+
+	d.SetId(meta.(*AWSClient).accountid)
+
+	return resourceAwsServiceQuotasTemplateAssociationRead(d, meta)
+}
+
+func resourceAwsServiceQuotasTemplateAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicequotasconn
+
+	output, err := conn.GetAssociationForServiceQuotaTemplate(&servicequotas.GetAssociationForServiceQuotaTemplateInput{})
+
+	if !d.IsNewResource() && isAWSErr(err, servicequotas.ErrCodeServiceQuotaTemplateNotInUseException, "") {
+		log.Printf("[WARN] Service Quotas Template Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error getting Service Quotas Template Association (%s): %s", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error getting Service Quotas Template Association (%s): empty result", d.Id())
+	}
+
+	status := aws.StringValue(output.ServiceQuotaTemplateAssociationStatus)
+
+	if !d.IsNewResource() && status == servicequotas.ServiceQuotaTemplateAssociationStatusDisassociated {
+		log.Printf("[WARN] Service Quotas Template Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("status", status)
+
+	return nil
+}
+
+func resourceAwsServiceQuotasTemplateAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicequotasconn
+
+	_, err := conn.DisassociateServiceQuotaTemplate(&servicequotas.DisassociateServiceQuotaTemplateInput{})
+
+	if isAWSErr(err, servicequotas.ErrCodeServiceQuotaTemplateNotInUseException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disassociating Service Quotas Template (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// The association is an account-wide singleton, so this test must not run in parallel.
+func TestAccAwsServiceQuotasTemplateAssociation_basic(t *testing.T) {
+	resourceName := "aws_servicequotas_template_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccRegionPreCheck(t, "us-east-1")
+			testAccOrganizationsEnabledPreCheck(t)
+			testAccPreCheckAWSServiceQuotas(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceQuotasTemplateAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsServiceQuotasTemplateAssociationConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceAttrAccountID(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "status", servicequotas.ServiceQuotaTemplateAssociationStatusAssociated),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsServiceQuotasTemplateAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).servicequotasconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicequotas_template_association" {
+			continue
+		}
+
+		output, err := conn.GetAssociationForServiceQuotaTemplate(&servicequotas.GetAssociationForServiceQuotaTemplateInput{})
+
+		if isAWSErr(err, servicequotas.ErrCodeServiceQuotaTemplateNotInUseException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(output.ServiceQuotaTemplateAssociationStatus); status != servicequotas.ServiceQuotaTemplateAssociationStatusDisassociated {
+			return fmt.Errorf("Service Quotas Template Association (%s) still exists: %s", rs.Primary.ID, status)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsServiceQuotasTemplateAssociationConfig() string {
+	return `
+resource "aws_servicequotas_template_association" "test" {}
+`
+}
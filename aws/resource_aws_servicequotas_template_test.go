@@ -0,0 +1,134 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// Service Quota Templates are only available from the us-east-1 region
+// of an AWS Organizations management account.
+func TestAccAwsServiceQuotasTemplate_basic(t *testing.T) {
+	resourceName := "aws_servicequotas_template.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccRegionPreCheck(t, "us-east-1")
+			testAccOrganizationsEnabledPreCheck(t)
+			testAccPreCheckAWSServiceQuotas(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceQuotasTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsServiceQuotasTemplateConfig("L-F678F1CE", "vpc", 7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceQuotasTemplateExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "region", "data.aws_region.current", "name"),
+					resource.TestCheckResourceAttr(resourceName, "quota_code", "L-F678F1CE"),
+					resource.TestCheckResourceAttrSet(resourceName, "quota_name"),
+					resource.TestCheckResourceAttr(resourceName, "service_code", "vpc"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_name"),
+					resource.TestCheckResourceAttr(resourceName, "value", "7"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsServiceQuotasTemplateConfig("L-F678F1CE", "vpc", 8),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceQuotasTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", "8"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsServiceQuotasTemplateExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Quotas Template ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).servicequotasconn
+
+		region, serviceCode, quotaCode, err := resourceAwsServiceQuotasTemplateParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		input := &servicequotas.GetServiceQuotaIncreaseRequestFromTemplateInput{
+			AwsRegion:   aws.String(region),
+			QuotaCode:   aws.String(quotaCode),
+			ServiceCode: aws.String(serviceCode),
+		}
+
+		_, err = conn.GetServiceQuotaIncreaseRequestFromTemplate(input)
+
+		return err
+	}
+}
+
+func testAccCheckAwsServiceQuotasTemplateDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).servicequotasconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicequotas_template" {
+			continue
+		}
+
+		region, serviceCode, quotaCode, err := resourceAwsServiceQuotasTemplateParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		input := &servicequotas.GetServiceQuotaIncreaseRequestFromTemplateInput{
+			AwsRegion:   aws.String(region),
+			QuotaCode:   aws.String(quotaCode),
+			ServiceCode: aws.String(serviceCode),
+		}
+
+		_, err = conn.GetServiceQuotaIncreaseRequestFromTemplate(input)
+
+		if isAWSErr(err, servicequotas.ErrCodeNoSuchResourceException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Service Quotas Template (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAwsServiceQuotasTemplateConfig(quotaCode, serviceCode string, value float64) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_servicequotas_template" "test" {
+  region       = data.aws_region.current.name
+  quota_code   = %[1]q
+  service_code = %[2]q
+  value        = %[3]v
+}
+`, quotaCode, serviceCode, value)
+}
@@ -62,9 +62,10 @@ func resourceAwsSesReceiptRule() *schema.Resource {
 			},
 
 			"tls_policy": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(ses.TlsPolicy_Values(), false),
 			},
 
 			"add_header_action": {
@@ -263,6 +264,13 @@ func resourceAwsSesReceiptRule() *schema.Resource {
 				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"encoding": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      ses.SNSActionEncodingUtf8,
+							ValidateFunc: validation.StringInSlice(ses.SNSActionEncoding_Values(), false),
+						},
+
 						"topic_arn": {
 							Type:     schema.TypeString,
 							Required: true,
@@ -277,6 +285,9 @@ func resourceAwsSesReceiptRule() *schema.Resource {
 				Set: func(v interface{}) int {
 					var buf bytes.Buffer
 					m := v.(map[string]interface{})
+					if v, ok := m["encoding"]; ok {
+						buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+					}
 					buf.WriteString(fmt.Sprintf("%s-", m["topic_arn"].(string)))
 					buf.WriteString(fmt.Sprintf("%d-", m["position"].(int)))
 
@@ -531,6 +542,7 @@ func resourceAwsSesReceiptRuleRead(d *schema.ResourceData, meta interface{}) err
 
 		if element.SNSAction != nil {
 			snsAction := map[string]interface{}{
+				"encoding":  aws.StringValue(element.SNSAction.Encoding),
 				"topic_arn": *element.SNSAction.TopicArn,
 				"position":  i + 1,
 			}
@@ -732,6 +744,10 @@ func buildReceiptRule(d *schema.ResourceData) *ses.ReceiptRule {
 				TopicArn: aws.String(elem["topic_arn"].(string)),
 			}
 
+			if v, ok := elem["encoding"].(string); ok && v != "" {
+				snsAction.Encoding = aws.String(v)
+			}
+
 			actions[elem["position"].(int)] = &ses.ReceiptAction{
 				SNSAction: snsAction,
 			}
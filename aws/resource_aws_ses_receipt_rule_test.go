@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfawsresource"
 )
 
 func TestAccAWSSESReceiptRule_basic(t *testing.T) {
@@ -63,6 +64,39 @@ func TestAccAWSSESReceiptRule_s3Action(t *testing.T) {
 	})
 }
 
+func TestAccAWSSESReceiptRule_snsAction(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_ses_receipt_rule.basic"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSSES(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSESReceiptRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESReceiptRuleSNSActionConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESReceiptRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tls_policy", "Optional"),
+					resource.TestCheckResourceAttr(resourceName, "sns_action.#", "1"),
+					tfawsresource.TestCheckTypeSetElemNestedAttrs(resourceName, "sns_action.*", map[string]string{
+						"encoding": "Base64",
+						"position": "1",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAwsSesReceiptRuleImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
 func TestAccAWSSESReceiptRule_order(t *testing.T) {
 	rInt := acctest.RandInt()
 	resource.ParallelTest(t, resource.TestCase{
@@ -363,6 +397,33 @@ resource "aws_ses_receipt_rule" "basic" {
 `, rInt, rInt)
 }
 
+func testAccAWSSESReceiptRuleSNSActionConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_ses_receipt_rule_set" "test" {
+  rule_set_name = "test-me-%[1]d"
+}
+
+resource "aws_sns_topic" "test" {
+  name = "ses-receipt-rule-test-%[1]d"
+}
+
+resource "aws_ses_receipt_rule" "basic" {
+  name          = "basic"
+  rule_set_name = aws_ses_receipt_rule_set.test.rule_set_name
+  recipients    = ["test@example.com"]
+  enabled       = true
+  scan_enabled  = true
+  tls_policy    = "Optional"
+
+  sns_action {
+    encoding  = "Base64"
+    topic_arn = aws_sns_topic.test.arn
+    position  = 1
+  }
+}
+`, rInt)
+}
+
 func testAccAWSSESReceiptRuleOrderConfig(rInt int) string {
 	return fmt.Sprintf(`
 resource "aws_ses_receipt_rule_set" "test" {
@@ -69,6 +69,39 @@ func resourceAwsWorkspacesDirectory() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"workspace_creation_properties": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"custom_security_group_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"default_ou": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"enable_internet_access": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"enable_maintenance_mode": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"user_enabled_as_local_administrator": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
 			"workspace_security_group_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -156,6 +189,18 @@ func resourceAwsWorkspacesDirectoryCreate(d *schema.ResourceData, meta interface
 	}
 	log.Printf("[DEBUG] WorkSpaces Directory %q self-service permissions are set", directoryId)
 
+	if v, ok := d.GetOk("workspace_creation_properties"); ok {
+		log.Printf("[DEBUG] Modifying WorkSpaces Directory %q creation properties...", directoryId)
+		_, err := conn.ModifyWorkspaceCreationProperties(&workspaces.ModifyWorkspaceCreationPropertiesInput{
+			ResourceId:                  aws.String(directoryId),
+			WorkspaceCreationProperties: expandWorkspaceCreationProperties(v.([]interface{})),
+		})
+		if err != nil {
+			return fmt.Errorf("error setting creation properties: %s", err)
+		}
+		log.Printf("[DEBUG] WorkSpaces Directory %q creation properties are set", directoryId)
+	}
+
 	return resourceAwsWorkspacesDirectoryRead(d, meta)
 }
 
@@ -188,6 +233,10 @@ func resourceAwsWorkspacesDirectoryRead(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("error setting self_service_permissions: %s", err)
 	}
 
+	if err := d.Set("workspace_creation_properties", flattenWorkspaceCreationProperties(directory.WorkspaceCreationProperties)); err != nil {
+		return fmt.Errorf("error setting workspace_creation_properties: %s", err)
+	}
+
 	if err := d.Set("ip_group_ids", flattenStringSet(directory.IpGroupIds)); err != nil {
 		return fmt.Errorf("error setting ip_group_ids: %s", err)
 	}
@@ -225,6 +274,20 @@ func resourceAwsWorkspacesDirectoryUpdate(d *schema.ResourceData, meta interface
 		log.Printf("[DEBUG] WorkSpaces Directory %q self-service permissions are set", d.Id())
 	}
 
+	if d.HasChange("workspace_creation_properties") {
+		log.Printf("[DEBUG] Modifying WorkSpaces Directory %q creation properties...", d.Id())
+		properties := d.Get("workspace_creation_properties").([]interface{})
+
+		_, err := conn.ModifyWorkspaceCreationProperties(&workspaces.ModifyWorkspaceCreationPropertiesInput{
+			ResourceId:                  aws.String(d.Id()),
+			WorkspaceCreationProperties: expandWorkspaceCreationProperties(properties),
+		})
+		if err != nil {
+			return fmt.Errorf("error updating creation properties: %s", err)
+		}
+		log.Printf("[DEBUG] WorkSpaces Directory %q creation properties are set", d.Id())
+	}
+
 	if d.HasChange("tags") {
 		o, n := d.GetChange("tags")
 		if err := keyvaluetags.WorkspacesUpdateTags(conn, d.Id(), o, n); err != nil {
@@ -361,3 +424,43 @@ func flattenSelfServicePermissions(permissions *workspaces.SelfservicePermission
 
 	return []interface{}{result}
 }
+
+func expandWorkspaceCreationProperties(properties []interface{}) *workspaces.WorkspaceCreationProperties {
+	if len(properties) == 0 || properties[0] == nil {
+		return nil
+	}
+
+	p := properties[0].(map[string]interface{})
+
+	result := &workspaces.WorkspaceCreationProperties{
+		EnableInternetAccess:            aws.Bool(p["enable_internet_access"].(bool)),
+		EnableMaintenanceMode:           aws.Bool(p["enable_maintenance_mode"].(bool)),
+		UserEnabledAsLocalAdministrator: aws.Bool(p["user_enabled_as_local_administrator"].(bool)),
+	}
+
+	if v, ok := p["custom_security_group_id"].(string); ok && v != "" {
+		result.CustomSecurityGroupId = aws.String(v)
+	}
+
+	if v, ok := p["default_ou"].(string); ok && v != "" {
+		result.DefaultOu = aws.String(v)
+	}
+
+	return result
+}
+
+func flattenWorkspaceCreationProperties(properties *workspaces.DefaultWorkspaceCreationProperties) []interface{} {
+	if properties == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"custom_security_group_id":            aws.StringValue(properties.CustomSecurityGroupId),
+			"default_ou":                          aws.StringValue(properties.DefaultOu),
+			"enable_internet_access":              aws.BoolValue(properties.EnableInternetAccess),
+			"enable_maintenance_mode":             aws.BoolValue(properties.EnableMaintenanceMode),
+			"user_enabled_as_local_administrator": aws.BoolValue(properties.UserEnabledAsLocalAdministrator),
+		},
+	}
+}
@@ -171,6 +171,39 @@ func TestAccAwsWorkspacesDirectory_subnetIds(t *testing.T) {
 	})
 }
 
+func TestAccAwsWorkspacesDirectory_workspaceCreationProperties(t *testing.T) {
+	var v workspaces.WorkspaceDirectory
+	rName := acctest.RandString(8)
+
+	resourceName := "aws_workspaces_directory.main"
+	resourceSecurityGroup := "aws_security_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckHasIAMRole(t, "workspaces_DefaultRole") },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWorkspacesDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspacesDirectoryConfig_workspaceCreationProperties(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAwsWorkspacesDirectoryExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "workspace_creation_properties.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "workspace_creation_properties.0.custom_security_group_id", resourceSecurityGroup, "id"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_creation_properties.0.default_ou", "OU=AWS,DC=Workgroup,DC=Example,DC=com"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_creation_properties.0.enable_internet_access", "true"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_creation_properties.0.enable_maintenance_mode", "false"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_creation_properties.0.user_enabled_as_local_administrator", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAwsWorkspacesDirectory_tags(t *testing.T) {
 	var v workspaces.WorkspaceDirectory
 	rName := acctest.RandString(8)
@@ -375,6 +408,101 @@ func TestFlattenSelfServicePermissions(t *testing.T) {
 	}
 }
 
+func TestExpandWorkspaceCreationProperties(t *testing.T) {
+	cases := []struct {
+		input    []interface{}
+		expected *workspaces.WorkspaceCreationProperties
+	}{
+		// Empty
+		{
+			input:    []interface{}{},
+			expected: nil,
+		},
+		// Full
+		{
+			input: []interface{}{
+				map[string]interface{}{
+					"custom_security_group_id":            "sg-123456789012",
+					"default_ou":                          "OU=AWS,DC=Workgroup,DC=Example,DC=com",
+					"enable_internet_access":              true,
+					"enable_maintenance_mode":             true,
+					"user_enabled_as_local_administrator": false,
+				},
+			},
+			expected: &workspaces.WorkspaceCreationProperties{
+				CustomSecurityGroupId:           aws.String("sg-123456789012"),
+				DefaultOu:                       aws.String("OU=AWS,DC=Workgroup,DC=Example,DC=com"),
+				EnableInternetAccess:            aws.Bool(true),
+				EnableMaintenanceMode:           aws.Bool(true),
+				UserEnabledAsLocalAdministrator: aws.Bool(false),
+			},
+		},
+		// Without optional strings
+		{
+			input: []interface{}{
+				map[string]interface{}{
+					"custom_security_group_id":            "",
+					"default_ou":                          "",
+					"enable_internet_access":              false,
+					"enable_maintenance_mode":             false,
+					"user_enabled_as_local_administrator": true,
+				},
+			},
+			expected: &workspaces.WorkspaceCreationProperties{
+				EnableInternetAccess:            aws.Bool(false),
+				EnableMaintenanceMode:           aws.Bool(false),
+				UserEnabledAsLocalAdministrator: aws.Bool(true),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		actual := expandWorkspaceCreationProperties(c.input)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Fatalf("expected\n\n%#+v\n\ngot\n\n%#+v", c.expected, actual)
+		}
+	}
+}
+
+func TestFlattenWorkspaceCreationProperties(t *testing.T) {
+	cases := []struct {
+		input    *workspaces.DefaultWorkspaceCreationProperties
+		expected []interface{}
+	}{
+		// Empty
+		{
+			input:    nil,
+			expected: []interface{}{},
+		},
+		// Full
+		{
+			input: &workspaces.DefaultWorkspaceCreationProperties{
+				CustomSecurityGroupId:           aws.String("sg-123456789012"),
+				DefaultOu:                       aws.String("OU=AWS,DC=Workgroup,DC=Example,DC=com"),
+				EnableInternetAccess:            aws.Bool(true),
+				EnableMaintenanceMode:           aws.Bool(true),
+				UserEnabledAsLocalAdministrator: aws.Bool(false),
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					"custom_security_group_id":            "sg-123456789012",
+					"default_ou":                          "OU=AWS,DC=Workgroup,DC=Example,DC=com",
+					"enable_internet_access":              true,
+					"enable_maintenance_mode":             true,
+					"user_enabled_as_local_administrator": false,
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		actual := flattenWorkspaceCreationProperties(c.input)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Fatalf("expected\n\n%#+v\n\ngot\n\n%#+v", c.expected, actual)
+		}
+	}
+}
+
 // Extract common infra
 func testAccAwsWorkspacesDirectoryConfig_Prerequisites(rName string) string {
 	return fmt.Sprintf(`
@@ -492,6 +620,27 @@ resource "aws_workspaces_directory" "main" {
 `
 }
 
+func testAccWorkspacesDirectoryConfig_workspaceCreationProperties(rName string) string {
+	return testAccAwsWorkspacesDirectoryConfig_Prerequisites(rName) + fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  vpc_id = aws_vpc.main.id
+  name   = "tf-testacc-workspaces-directory-%[1]s"
+}
+
+resource "aws_workspaces_directory" "main" {
+  directory_id = aws_directory_service_directory.main.id
+
+  workspace_creation_properties {
+    custom_security_group_id            = aws_security_group.test.id
+    default_ou                          = "OU=AWS,DC=Workgroup,DC=Example,DC=com"
+    enable_internet_access              = true
+    enable_maintenance_mode             = false
+    user_enabled_as_local_administrator = false
+  }
+}
+`, rName)
+}
+
 func testAccWorkspacesDirectoryConfigTags1(rName, tagKey1, tagValue1 string) string {
 	return testAccAwsWorkspacesDirectoryConfig_Prerequisites(rName) + fmt.Sprintf(`
 resource "aws_workspaces_directory" "main" {
@@ -5120,6 +5120,42 @@ func expandAppmeshRouteSpec(vSpec []interface{}) *appmesh.RouteSpec {
 
 			spec.HttpRoute.Match = httpRouteMatch
 		}
+
+		if vHttpRetryPolicy, ok := mHttpRoute["retry_policy"].([]interface{}); ok && len(vHttpRetryPolicy) > 0 && vHttpRetryPolicy[0] != nil {
+			httpRetryPolicy := &appmesh.HttpRetryPolicy{}
+
+			mHttpRetryPolicy := vHttpRetryPolicy[0].(map[string]interface{})
+
+			if vMaxRetries, ok := mHttpRetryPolicy["max_retries"].(int); ok {
+				httpRetryPolicy.MaxRetries = aws.Int64(int64(vMaxRetries))
+			}
+			if vHttpRetryEvents, ok := mHttpRetryPolicy["http_retry_events"].(*schema.Set); ok && vHttpRetryEvents.Len() > 0 {
+				httpRetryPolicy.HttpRetryEvents = expandStringSet(vHttpRetryEvents)
+			}
+			if vTcpRetryEvents, ok := mHttpRetryPolicy["tcp_retry_events"].(*schema.Set); ok && vTcpRetryEvents.Len() > 0 {
+				httpRetryPolicy.TcpRetryEvents = expandStringSet(vTcpRetryEvents)
+			}
+			if vPerRetryTimeout, ok := mHttpRetryPolicy["per_retry_timeout"].([]interface{}); ok {
+				httpRetryPolicy.PerRetryTimeout = expandAppmeshDuration(vPerRetryTimeout)
+			}
+
+			spec.HttpRoute.RetryPolicy = httpRetryPolicy
+		}
+
+		if vHttpTimeout, ok := mHttpRoute["timeout"].([]interface{}); ok && len(vHttpTimeout) > 0 && vHttpTimeout[0] != nil {
+			httpTimeout := &appmesh.HttpTimeout{}
+
+			mHttpTimeout := vHttpTimeout[0].(map[string]interface{})
+
+			if vIdle, ok := mHttpTimeout["idle"].([]interface{}); ok {
+				httpTimeout.Idle = expandAppmeshDuration(vIdle)
+			}
+			if vPerRequest, ok := mHttpTimeout["per_request"].([]interface{}); ok {
+				httpTimeout.PerRequest = expandAppmeshDuration(vPerRequest)
+			}
+
+			spec.HttpRoute.Timeout = httpTimeout
+		}
 	}
 
 	if vTcpRoute, ok := mSpec["tcp_route"].([]interface{}); ok && len(vTcpRoute) > 0 && vTcpRoute[0] != nil {
@@ -5233,6 +5269,26 @@ func flattenAppmeshRouteSpec(spec *appmesh.RouteSpec) []interface{} {
 			}
 		}
 
+		if httpRetryPolicy := httpRoute.RetryPolicy; httpRetryPolicy != nil {
+			mHttpRoute["retry_policy"] = []interface{}{
+				map[string]interface{}{
+					"http_retry_events": flattenStringSet(httpRetryPolicy.HttpRetryEvents),
+					"max_retries":       int(aws.Int64Value(httpRetryPolicy.MaxRetries)),
+					"per_retry_timeout": flattenAppmeshDuration(httpRetryPolicy.PerRetryTimeout),
+					"tcp_retry_events":  flattenStringSet(httpRetryPolicy.TcpRetryEvents),
+				},
+			}
+		}
+
+		if httpTimeout := httpRoute.Timeout; httpTimeout != nil {
+			mHttpRoute["timeout"] = []interface{}{
+				map[string]interface{}{
+					"idle":        flattenAppmeshDuration(httpTimeout.Idle),
+					"per_request": flattenAppmeshDuration(httpTimeout.PerRequest),
+				},
+			}
+		}
+
 		mSpec["http_route"] = []interface{}{mHttpRoute}
 	}
 
@@ -5266,6 +5322,38 @@ func flattenAppmeshRouteSpec(spec *appmesh.RouteSpec) []interface{} {
 	return []interface{}{mSpec}
 }
 
+func expandAppmeshDuration(vDuration []interface{}) *appmesh.Duration {
+	if len(vDuration) == 0 || vDuration[0] == nil {
+		return nil
+	}
+
+	duration := &appmesh.Duration{}
+
+	mDuration := vDuration[0].(map[string]interface{})
+
+	if vUnit, ok := mDuration["unit"].(string); ok && vUnit != "" {
+		duration.Unit = aws.String(vUnit)
+	}
+	if vValue, ok := mDuration["value"].(int); ok {
+		duration.Value = aws.Int64(int64(vValue))
+	}
+
+	return duration
+}
+
+func flattenAppmeshDuration(duration *appmesh.Duration) []interface{} {
+	if duration == nil {
+		return []interface{}{}
+	}
+
+	mDuration := map[string]interface{}{
+		"unit":  aws.StringValue(duration.Unit),
+		"value": int(aws.Int64Value(duration.Value)),
+	}
+
+	return []interface{}{mDuration}
+}
+
 func expandRoute53ResolverEndpointIpAddresses(vIpAddresses *schema.Set) []*route53resolver.IpAddressRequest {
 	ipAddressRequests := []*route53resolver.IpAddressRequest{}
 
@@ -1,8 +1,10 @@
 package aws
 
 import (
+	"encoding/xml"
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"net/url"
 	"regexp"
@@ -946,6 +948,20 @@ func validateS3BucketLifecycleTransitionStorageClass() schema.SchemaValidateFunc
 	}, false)
 }
 
+// validateAmiImageLocation validates the location of an AMI manifest in
+// Amazon S3, which must be given as bucket/path without an s3:// prefix.
+func validateAmiImageLocation(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "" {
+		return
+	}
+	if !regexp.MustCompile(`^[0-9A-Za-z][0-9A-Za-z._-]{1,254}/[^/\s]\S*$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be an Amazon S3 manifest location in the form bucket/path, got: %s", k, value))
+	}
+	return
+}
+
 func validateDbEventSubscriptionName(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if !regexp.MustCompile(`^[0-9A-Za-z-]+$`).MatchString(value) {
@@ -996,6 +1012,14 @@ func validateApiGatewayIntegrationContentHandling() schema.SchemaValidateFunc {
 	}, false)
 }
 
+func validateApiGatewayStageAccessLogFormat(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`\$context\.(requestId|extendedRequestId)\b`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must include $context.requestId or $context.extendedRequestId", k))
+	}
+	return
+}
+
 func validateSQSQueueName(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if len(value) > 80 {
@@ -2447,3 +2471,71 @@ var validateServiceDiscoveryNamespaceName = validation.All(
 	validation.StringLenBetween(1, 1024),
 	validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z._-]+$`), ""),
 )
+
+const pollyLexiconNamespace = "http://www.w3.org/2005/01/pronunciation-lexicon"
+
+// validatePollyLexiconContent validates that the value is a well-formed
+// Pronunciation Lexicon Specification (PLS) document with a single lexicon
+// root element carrying the attributes Amazon Polly requires.
+func validatePollyLexiconContent(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	decoder := xml.NewDecoder(strings.NewReader(value))
+
+	var root *xml.StartElement
+	depth := 0
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			errors = append(errors, fmt.Errorf("%q contains invalid XML: %s", k, err))
+			return
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if depth == 0 {
+				if root != nil {
+					errors = append(errors, fmt.Errorf("%q must contain a single lexicon root element", k))
+					return
+				}
+				element := t.Copy()
+				root = &element
+			}
+			depth++
+		case xml.EndElement:
+			depth--
+		}
+	}
+
+	if root == nil {
+		errors = append(errors, fmt.Errorf("%q must contain a PLS lexicon document", k))
+		return
+	}
+
+	if root.Name.Local != "lexicon" || root.Name.Space != pollyLexiconNamespace {
+		errors = append(errors, fmt.Errorf(
+			"%q root element must be lexicon in the %s namespace, got: %s", k, pollyLexiconNamespace, root.Name.Local))
+		return
+	}
+
+	for _, required := range []xml.Name{
+		{Local: "version"},
+		{Local: "alphabet"},
+		{Space: "http://www.w3.org/XML/1998/namespace", Local: "lang"},
+	} {
+		found := false
+		for _, attr := range root.Attr {
+			if attr.Name == required && attr.Value != "" {
+				found = true
+				break
+			}
+		}
+		if !found {
+			errors = append(errors, fmt.Errorf("%q lexicon element must have a non-empty %s attribute", k, required.Local))
+		}
+	}
+
+	return
+}
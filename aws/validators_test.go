@@ -703,6 +703,38 @@ func TestValidateS3BucketLifecycleTimestamp(t *testing.T) {
 	}
 }
 
+func TestValidateAmiImageLocation(t *testing.T) {
+	validLocations := []string{
+		"",
+		"my-bucket/image.manifest.xml",
+		"my.bucket/path/to/image.manifest.xml",
+		"123456789012/my-ami",
+	}
+
+	for _, v := range validLocations {
+		_, errors := validateAmiImageLocation(v, "image_location")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid image location: %q", v, errors)
+		}
+	}
+
+	invalidLocations := []string{
+		"image.manifest.xml",
+		"my-bucket/",
+		"my-bucket//image.manifest.xml",
+		"/my-bucket/image.manifest.xml",
+		"s3://my-bucket/image.manifest.xml",
+		"my-bucket/path with spaces/image.manifest.xml",
+	}
+
+	for _, v := range invalidLocations {
+		_, errors := validateAmiImageLocation(v, "image_location")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid image location", v)
+		}
+	}
+}
+
 func TestValidateSagemakerName(t *testing.T) {
 	validNames := []string{
 		"ValidSageMakerName",
@@ -867,6 +899,32 @@ func TestValidateStringIsJsonOrYaml(t *testing.T) {
 	}
 }
 
+func TestValidateApiGatewayStageAccessLogFormat(t *testing.T) {
+	validFormats := []string{
+		`$context.requestId`,
+		`$context.extendedRequestId`,
+		`$context.identity.sourceIp $context.httpMethod $context.status $context.requestId`,
+		`{ "requestId":"$context.requestId", "status":"$context.status" }`,
+	}
+	for _, v := range validFormats {
+		if _, errors := validateApiGatewayStageAccessLogFormat(v, "format"); len(errors) > 0 {
+			t.Fatalf("%q should be a valid API Gateway Stage access log format: %q", v, errors)
+		}
+	}
+
+	invalidFormats := []string{
+		"",
+		"requestId",
+		`$context.identity.sourceIp $context.httpMethod $context.status`,
+		`$context.requestIdentifier`,
+	}
+	for _, v := range invalidFormats {
+		if _, errors := validateApiGatewayStageAccessLogFormat(v, "format"); len(errors) == 0 {
+			t.Fatalf("%q should be an invalid API Gateway Stage access log format", v)
+		}
+	}
+}
+
 func TestValidateSQSQueueName(t *testing.T) {
 	validNames := []string{
 		"valid-name",
@@ -3156,3 +3214,43 @@ func TestValidateServiceDiscoveryNamespaceName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidatePollyLexiconContent(t *testing.T) {
+	validContents := []string{
+		`<?xml version="1.0" encoding="UTF-8"?>
+<lexicon version="1.0" xmlns="http://www.w3.org/2005/01/pronunciation-lexicon" alphabet="ipa" xml:lang="en-US">
+  <lexeme>
+    <grapheme>W3C</grapheme>
+    <alias>World Wide Web Consortium</alias>
+  </lexeme>
+</lexicon>`,
+		`<lexicon version="1.0" xmlns="http://www.w3.org/2005/01/pronunciation-lexicon" alphabet="x-sampa" xml:lang="de-DE"/>`,
+	}
+
+	for _, v := range validContents {
+		_, errors := validatePollyLexiconContent(v, "content")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid PLS lexicon: %q", v, errors)
+		}
+	}
+
+	invalidContents := []string{
+		"",
+		"not xml",
+		`<lexicon version="1.0" xmlns="http://www.w3.org/2005/01/pronunciation-lexicon" alphabet="ipa" xml:lang="en-US">`,
+		`<lexicon version="1.0" xmlns="http://www.w3.org/2005/01/pronunciation-lexicon" alphabet="ipa" xml:lang="en-US"><lexeme></lexicon>`,
+		`<lexicon version="1.0" alphabet="ipa" xml:lang="en-US"></lexicon>`,
+		`<speak version="1.0" xmlns="http://www.w3.org/2005/01/pronunciation-lexicon" alphabet="ipa" xml:lang="en-US"></speak>`,
+		`<lexicon xmlns="http://www.w3.org/2005/01/pronunciation-lexicon" alphabet="ipa" xml:lang="en-US"></lexicon>`,
+		`<lexicon version="1.0" xmlns="http://www.w3.org/2005/01/pronunciation-lexicon" xml:lang="en-US"></lexicon>`,
+		`<lexicon version="1.0" xmlns="http://www.w3.org/2005/01/pronunciation-lexicon" alphabet="ipa"></lexicon>`,
+		`<lexicon version="1.0" xmlns="http://www.w3.org/2005/01/pronunciation-lexicon" alphabet="ipa" xml:lang="en-US"></lexicon><lexicon version="1.0" xmlns="http://www.w3.org/2005/01/pronunciation-lexicon" alphabet="ipa" xml:lang="en-US"></lexicon>`,
+	}
+
+	for _, v := range invalidContents {
+		_, errors := validatePollyLexiconContent(v, "content")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid PLS lexicon", v)
+		}
+	}
+}
@@ -150,6 +150,7 @@ variable "service_labels" {
     "ram",
     "rds",
     "redshift",
+    "rekognition",
     "resourcegroups",
     "robomaker",
     "route53",